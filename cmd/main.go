@@ -20,15 +20,19 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"os"
 	"path"
+	"time"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
 	"github.com/datacommonsorg/mixer/internal/server"
 	"github.com/datacommonsorg/mixer/internal/server/healthcheck"
-	"github.com/datacommonsorg/mixer/internal/server/resource"
+	"github.com/datacommonsorg/mixer/internal/server/ranking"
+	"github.com/datacommonsorg/mixer/internal/server/recon"
 	"github.com/datacommonsorg/mixer/internal/store"
 	"github.com/datacommonsorg/mixer/internal/store/bigtable"
 	"github.com/datacommonsorg/mixer/internal/store/memdb"
+	"github.com/datacommonsorg/mixer/internal/store/sqldb"
 	"github.com/datacommonsorg/mixer/internal/util"
 	"golang.org/x/oauth2/google"
 
@@ -51,9 +55,18 @@ var (
 	useBigquery = flag.Bool("use_bigquery", true, "Use Bigquery to serve Sparql Query")
 	bqDataset   = flag.String("bq_dataset", "", "DataCommons BigQuery dataset.")
 	schemaPath  = flag.String("schema_path", "", "The directory that contains the schema mapping files")
+	// Sparql query backend
+	queryBackend  = flag.String("query_backend", "bigquery", "Backend to run Sparql query: bigquery or sqlite")
+	sqliteDataDir = flag.String("sqlite_data_dir", "", "Local directory of MCF and TMCF + CSV files to load into sqlite")
 	// Base Bigtable Cache
 	useBaseBt         = flag.Bool("use_base_bt", true, "Use base bigtable cache")
+	cacheBackend      = flag.String("cache_backend", "bigtable", "Backend of the base cache: bigtable, local or snapshot")
+	localCacheDir     = flag.String("local_cache_dir", "", "Local directory of the cache files, one per import group table")
+	cacheSnapshot     = flag.String("cache_snapshot", "", "Path of the cache snapshot file exported by cmd/snapshot")
 	importGroupTables = flag.String("import_group_tables", "", "Newline separated list of import group tables")
+	// Hot-reload of import group tables.
+	importGroupManifest    = flag.String("import_group_manifest", "", "GCS path (gs://bucket/object) or local path of the manifest of import group tables, reloaded on pubsub messages")
+	importGroupPubsubTopic = flag.String("import_group_pubsub_topic", "import-group-tables-reload", "Pubsub topic of import group table updates")
 	// Branch Bigtable Cache
 	useBranchBt = flag.Bool("use_branch_bt", true, "Use branch bigtable cache")
 	// GCS to hold memdb data.
@@ -61,10 +74,14 @@ var (
 	useTmcfCsvData = flag.Bool("use_tmcf_csv_data", false, "Use tmcf and csv data")
 	tmcfCsvBucket  = flag.String("tmcf_csv_bucket", "", "The GCS bucket that contains tmcf and csv files")
 	tmcfCsvFolder  = flag.String("tmcf_csv_folder", "", "GCS folder for an import. An import must have a unique prefix within a bucket.")
+	tmcfCsvDir     = flag.String("tmcf_csv_dir", "", "Local directory of the tmcf and csv files, used instead of GCS")
 	memdbPath      = flag.String("memdb_path", "", "File path of memdb config")
 	// Specify what services to serve
-	serveMixerService = flag.Bool("serve_mixer_service", true, "Serve Mixer service")
-	serveReconService = flag.Bool("serve_recon_service", false, "Serve Recon service")
+	serveMixerService     = flag.Bool("serve_mixer_service", true, "Serve Mixer service")
+	serveReconService     = flag.Bool("serve_recon_service", false, "Serve Recon service")
+	useReconNameIndex     = flag.Bool("use_recon_name_index", false, "Use name index built from the place metadata cache for name-based entity recon")
+	rankingConfig         = flag.String("ranking_config", "", "JSON or YAML file of the import group and source ranking, reloaded when changed")
+	reconPolygonCacheSize = flag.Int("recon_polygon_cache_size", 10000, "Max number of parsed place polygons cached for coordinate recon, 0 to disable")
 )
 
 const (
@@ -74,8 +91,13 @@ const (
 	branchBtInstance            = "prophet-branch-cache"
 	branchCacheVersionBucket    = "datcom-control"
 	branchCacheSubscriberPrefix = "branch-cache-subscriber-"
+	importGroupSubscriberPrefix = "import-group-subscriber-"
 	// Memdb config file name
 	memdbConfig = "memdb.json"
+	// Interval to probe the health of Bigtable.
+	healthProbeInterval = 30 * time.Second
+	// Interval to check for ranking config changes.
+	rankingConfigReloadInterval = time.Minute
 )
 
 func main() {
@@ -99,7 +121,10 @@ func main() {
 		}
 	}
 
-	opts := []grpc.ServerOption{}
+	// Calls other than health checks are rejected until the services are loaded.
+	gate := &healthcheck.Gate{}
+	opts := []grpc.ServerOption{grpc.ChainUnaryInterceptor(
+		gate.UnaryInterceptor, server.ImportGroupInterceptor)}
 	// Use ALTS server credential to bind to VM's private IPv6 interface.
 	if *useALTS {
 		altsTC := alts.NewServerCreds(alts.DefaultServerOptions())
@@ -109,6 +134,51 @@ func main() {
 	// Create grpc server.
 	srv := grpc.NewServer(opts...)
 
+	// Health checker tracks the readiness of the components created below.
+	// Register all of them up front, so the services are not serving until
+	// the components are loaded.
+	healthService := healthcheck.NewHealthChecker()
+	if *serveMixerService {
+		healthService.AddService(healthcheck.ServiceMixer)
+		healthService.AddComponent(healthcheck.ComponentBigtable, healthcheck.ServiceMixer)
+		healthService.AddComponent(healthcheck.ComponentCache, healthcheck.ServiceMixer)
+		healthService.AddComponent(healthcheck.ComponentSQLiteIndex, healthcheck.ServiceMixer)
+		if *useTmcfCsvData && (*tmcfCsvBucket != "" || *tmcfCsvDir != "") {
+			healthService.AddComponent(healthcheck.ComponentMemDb, healthcheck.ServiceMixer)
+		}
+		if *useBigquery {
+			healthService.AddComponent(healthcheck.ComponentBigQuery, healthcheck.ServiceMixer)
+		}
+		if *useBranchBt {
+			healthService.AddComponent(healthcheck.ComponentPubsub, healthcheck.ServiceMixer)
+		}
+	}
+	if *serveReconService {
+		healthService.AddService(healthcheck.ServiceRecon)
+		healthService.AddComponent(healthcheck.ComponentBigtable, healthcheck.ServiceRecon)
+	}
+
+	// Services can only be registered before serving, so they are registered
+	// up front and set once loaded. Health checks are served while loading.
+	lazyMixer := &lazyMixerServer{}
+	if *serveMixerService {
+		pb.RegisterMixerServer(srv, lazyMixer)
+	}
+	lazyRecon := &lazyReconServer{}
+	if *serveReconService {
+		pb.RegisterReconServer(srv, lazyRecon)
+	}
+	grpc_health_v1.RegisterHealthServer(srv, healthService)
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", *port))
+	if err != nil {
+		log.Fatalf("Failed to listen on network: %v", err)
+	}
+	go func() {
+		if err := srv.Serve(lis); err != nil {
+			log.Fatalf("Failed to serve: %v", err)
+		}
+	}()
+
 	// Metadata.
 	metadata, err := server.NewMetadata(
 		*bqDataset, *storeProject, branchBtInstance, *schemaPath)
@@ -122,29 +192,56 @@ func main() {
 	// Base Bigtable cache
 	var tables []*bigtable.Table
 	if *useBaseBt {
-		tableNames := util.ParseBigtableGroup(*importGroupTables)
-		for _, name := range tableNames {
-			t, err := bigtable.NewBtTable(ctx, *storeProject, baseBtInstance, name)
+		switch *cacheBackend {
+		case "bigtable":
+			tableNames := util.ParseBigtableGroup(*importGroupTables)
+			for _, name := range tableNames {
+				t, err := bigtable.NewBtTable(ctx, *storeProject, baseBtInstance, name)
+				if err != nil {
+					log.Fatalf("Failed to create BigTable client: %v", err)
+				}
+				tables = append(tables, bigtable.NewTable(name, t))
+			}
+		case "local":
+			tables, err = bigtable.LoadLocalTables(*localCacheDir)
 			if err != nil {
-				log.Fatalf("Failed to create BigTable client: %v", err)
+				log.Fatalf("Failed to load local cache: %v", err)
 			}
-			tables = append(tables, bigtable.NewTable(name, t))
+		case "snapshot":
+			f, err := os.Open(*cacheSnapshot)
+			if err != nil {
+				log.Fatalf("Failed to open cache snapshot: %v", err)
+			}
+			tables, err = bigtable.LoadSnapshot(f)
+			f.Close()
+			if err != nil {
+				log.Fatalf("Failed to load cache snapshot: %v", err)
+			}
+		default:
+			log.Fatalf("Invalid cache backend: %s", *cacheBackend)
 		}
 	}
 
+	// Bigtable groups of the stores, to sort by the ranking config.
+	btGroups := []*bigtable.Group{}
 	if *serveMixerService {
 		// TMCF + CSV from GCS
 		memDb := memdb.NewMemDb()
-		if *useTmcfCsvData && *tmcfCsvBucket != "" {
+		if *useTmcfCsvData && (*tmcfCsvBucket != "" || *tmcfCsvDir != "") {
 			// Read memdb config
 			err = memDb.LoadConfig(ctx, path.Join(*memdbPath, memdbConfig))
 			if err != nil {
 				log.Fatalf("Failed to load config: %v", err)
 			}
-			err = memDb.LoadFromGcs(ctx, *tmcfCsvBucket, *tmcfCsvFolder)
+			if *tmcfCsvDir != "" {
+				err = memDb.LoadFromDir(ctx, *tmcfCsvDir)
+			} else {
+				err = memDb.LoadFromGcs(ctx, *tmcfCsvBucket, *tmcfCsvFolder)
+			}
 			if err != nil {
-				log.Fatalf("Failed to load tmcf and csv from GCS: %v", err)
+				log.Fatalf("Failed to load tmcf and csv: %v", err)
 			}
+			healthService.SetReady(healthcheck.ComponentMemDb, true)
 		}
 
 		// BigQuery.
@@ -154,6 +251,8 @@ func main() {
 			if err != nil {
 				log.Fatalf("Failed to create Bigquery client: %v", err)
 			}
+			healthService.Probe(ctx, healthcheck.ComponentBigQuery, healthProbeInterval,
+				healthcheck.BigQueryProbe(bqClient))
 		}
 
 		// Branch Bigtable cache
@@ -173,25 +272,43 @@ func main() {
 
 		// Store
 		store := store.NewStore(bqClient, memDb, tables, branchTableName)
+		btGroups = append(btGroups, store.BtGroup)
+		healthService.Probe(
+			ctx, healthcheck.ComponentBigtable, healthProbeInterval, store.BtGroup.Probe)
+
+		// Local SQLite database to serve Sparql query.
+		switch *queryBackend {
+		case "bigquery":
+		case "sqlite":
+			store.SQLDb, err = sqldb.Load(
+				*sqliteDataDir, metadata.Mappings, metadata.SubTypeMap)
+			if err != nil {
+				log.Fatalf("Failed to load sqlite data: %v", err)
+			}
+		default:
+			log.Fatalf("Invalid query backend: %s", *queryBackend)
+		}
 		// Build the cache that includes stat var group info and stat var search
 		// Index.
 		// !!Important: do this after creating the memdb, since the cache will
 		// need to merge svg info from memdb.
-		var cache *resource.Cache
-		if *serveMixerService {
-			cache, err = server.NewCache(ctx, store, server.SearchOptions{
-				UseSearch:           true,
-				BuildSvgSearchIndex: true,
-				BuildSqliteIndex:    true,
-			})
-			if err != nil {
-				log.Fatalf("Failed to create cache: %v", err)
-			}
+		cache, err := server.NewCache(ctx, store, server.SearchOptions{
+			UseSearch:           true,
+			BuildSvgSearchIndex: true,
+			BuildSqliteIndex:    true,
+		})
+		if err != nil {
+			log.Fatalf("Failed to create cache: %v", err)
 		}
+		// Source ranking rules of a stat var group apply to its stat vars.
+		ranking.SetParentSvg(cache.ParentSvg)
+		healthService.SetReady(healthcheck.ComponentCache, true)
 
 		// Create server object
 		mixerServer := server.NewMixerServer(store, metadata, cache)
-		pb.RegisterMixerServer(srv, mixerServer)
+		healthService.Probe(ctx, healthcheck.ComponentSQLiteIndex, healthProbeInterval,
+			mixerServer.ProbeSQLiteIndex)
+		lazyMixer.MixerServer = mixerServer
 
 		// Subscribe to branch cache update
 		if *useBranchBt {
@@ -200,27 +317,75 @@ func main() {
 			if err != nil {
 				log.Fatalf("Failed to subscribe to branch cache update: %v", err)
 			}
+			healthService.SetReady(healthcheck.ComponentPubsub, true)
+		}
+		if *importGroupManifest != "" {
+			err := mixerServer.SubscribeImportGroupUpdate(ctx, importGroupUpdateOptions())
+			if err != nil {
+				log.Fatalf("Failed to subscribe to import group update: %v", err)
+			}
 		}
 	}
 
 	// Register for Recon Service.
 	if *serveReconService {
 		store := store.NewStore(nil, nil, tables, "")
-		reconServer := server.NewReconServer(store)
-		pb.RegisterReconServer(srv, reconServer)
+		btGroups = append(btGroups, store.BtGroup)
+		if !*serveMixerService {
+			healthService.Probe(
+				ctx, healthcheck.ComponentBigtable, healthProbeInterval, store.BtGroup.Probe)
+		}
+		var nameIndex *recon.NameIndex
+		if *useReconNameIndex {
+			nameIndex, err = recon.LoadNameIndex(ctx, store)
+			if err != nil {
+				log.Fatalf("Failed to load recon name index: %v", err)
+			}
+		}
+		reconServer := server.NewReconServer(
+			store, nameIndex, recon.NewPolygonCache(*reconPolygonCacheSize))
+		lazyRecon.ReconServer = reconServer
+		if *importGroupManifest != "" {
+			err := reconServer.SubscribeImportGroupUpdate(ctx, importGroupUpdateOptions())
+			if err != nil {
+				log.Fatalf("Failed to subscribe to import group update: %v", err)
+			}
+		}
 	}
 
-	// Register for healthcheck.
-	healthService := healthcheck.NewHealthChecker()
-	grpc_health_v1.RegisterHealthServer(srv, healthService)
-
-	// Listen on network
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", *port))
-	if err != nil {
-		log.Fatalf("Failed to listen on network: %v", err)
+	// Ranking config.
+	if *rankingConfig != "" {
+		err := server.WatchRankingConfig(
+			ctx, *rankingConfig, rankingConfigReloadInterval, btGroups...)
+		if err != nil {
+			log.Fatalf("Failed to load ranking config: %v", err)
+		}
 	}
+
+	gate.Open()
 	log.Println("Mixer ready to serve!!")
-	if err := srv.Serve(lis); err != nil {
-		log.Fatalf("Failed to serve: %v", err)
+	select {}
+}
+
+// lazyMixerServer is registered before serving, and calls the mixer server
+// that is set once loaded.
+type lazyMixerServer struct {
+	pb.MixerServer
+}
+
+// lazyReconServer is registered before serving, and calls the recon server
+// that is set once loaded.
+type lazyReconServer struct {
+	pb.ReconServer
+}
+
+func importGroupUpdateOptions() server.ImportGroupUpdateOptions {
+	return server.ImportGroupUpdateOptions{
+		PubsubProject:    *storeProject,
+		SubscriberPrefix: importGroupSubscriberPrefix,
+		PubsubTopic:      *importGroupPubsubTopic,
+		Manifest:         *importGroupManifest,
+		BtProject:        *storeProject,
+		BtInstance:       baseBtInstance,
 	}
 }
@@ -0,0 +1,72 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command snapshot exports the rows of some key prefixes from the import group
+// Bigtables into a local snapshot file, which mixer can serve with
+// --cache_backend=snapshot.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/datacommonsorg/mixer/internal/store/bigtable"
+	"github.com/datacommonsorg/mixer/internal/util"
+)
+
+var (
+	storeProject      = flag.String("store_project", "", "GCP project of the Bigtables")
+	btInstance        = flag.String("bt_instance", "prophet-cache", "Bigtable instance of the import group tables")
+	importGroupTables = flag.String("import_group_tables", "", "Newline separated list of import group tables")
+	prefixes          = flag.String("prefixes", "", "Comma separated list of key prefixes to export, like d/3/,d/c/")
+	output            = flag.String("output", "snapshot.gz", "Path of the snapshot file")
+)
+
+func main() {
+	flag.Parse()
+	log.SetFlags(log.LstdFlags | log.Lshortfile)
+	ctx := context.Background()
+
+	if *prefixes == "" {
+		log.Fatalf("--prefixes is required")
+	}
+	tables := []*bigtable.Table{}
+	for _, name := range util.ParseBigtableGroup(*importGroupTables) {
+		t, err := bigtable.NewBtTable(ctx, *storeProject, *btInstance, name)
+		if err != nil {
+			log.Fatalf("Failed to create BigTable client: %v", err)
+		}
+		tables = append(tables, bigtable.NewTable(name, t))
+	}
+	if len(tables) == 0 {
+		log.Fatalf("--import_group_tables is required")
+	}
+
+	f, err := os.Create(*output)
+	if err != nil {
+		log.Fatalf("Failed to create %s: %v", *output, err)
+	}
+	count, err := bigtable.ExportSnapshot(
+		ctx, bigtable.NewGroup(tables, ""), strings.Split(*prefixes, ","), f)
+	if err != nil {
+		log.Fatalf("Failed to export snapshot: %v", err)
+	}
+	if err := f.Close(); err != nil {
+		log.Fatalf("Failed to write %s: %v", *output, err)
+	}
+	log.Printf("Exported %d rows to %s", count, *output)
+}
@@ -28,4 +28,5 @@ require (
 	google.golang.org/genproto v0.0.0-20220329172620-7be39ac1afc7
 	google.golang.org/grpc v1.45.0
 	google.golang.org/protobuf v1.28.0
+	sigs.k8s.io/yaml v1.3.0
 )
@@ -33,6 +33,34 @@ type TableSchema struct {
 	ColumnInfo map[string][]*Column
 	// Keyed by node name and property.
 	NodeSchema map[string]map[string]string
+	// Keyed by node name and property, whether the value in NodeSchema refers
+	// to a node, like "dcs:Count_Person", rather than being a string.
+	NodeRefs map[string]map[string]bool
+}
+
+// referencePrefixes are the namespace prefixes of node references.
+var referencePrefixes = []string{"dcs:", "dcid:", "schema:"}
+
+// IsReference checks whether a TMCF value, or a CSV cell mapped to a property,
+// refers to a node by a namespace prefix, like "dcid:geoId/06".
+func IsReference(value string) bool {
+	for _, prefix := range referencePrefixes {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// TrimValue removes the namespace prefix and quotes of a TMCF value, or of a
+// CSV cell mapped to a property, like "dcs:CensusPEPSurvey" or "P1M".
+func TrimValue(value string) string {
+	for _, prefix := range referencePrefixes {
+		value = strings.TrimPrefix(value, prefix)
+	}
+	// Remove quote in TMCF schema like:
+	// observationPeriod: "P1M"
+	return strings.Trim(value, "\"")
 }
 
 // ParseTmcf parses TMCF into a map with key of the table name, and value being the
@@ -71,6 +99,7 @@ func ParseTmcf(tmcf string) (map[string]*TableSchema, error) {
 				result[table] = &TableSchema{
 					ColumnInfo: map[string][]*Column{},
 					NodeSchema: map[string]map[string]string{},
+					NodeRefs:   map[string]map[string]bool{},
 				}
 			}
 		} else if strings.HasPrefix(body, PreC) {
@@ -88,13 +117,7 @@ func ParseTmcf(tmcf string) (map[string]*TableSchema, error) {
 			)
 		} else {
 			// This is a schema
-			schema := body
-			for _, prefix := range []string{"dcs:", "dcid:", "schema:"} {
-				schema = strings.TrimPrefix(schema, prefix)
-			}
-			// Remove quote in TMCF schema like:
-			// observationPeriod: "P1M"
-			schema = strings.Trim(schema, "\"")
+			schema := TrimValue(body)
 			if table == "" || node == "" {
 				return nil, status.Errorf(codes.Internal, "Invalid input for Column:\n%s", line)
 			}
@@ -102,6 +125,12 @@ func ParseTmcf(tmcf string) (map[string]*TableSchema, error) {
 				result[table].NodeSchema[node] = map[string]string{}
 			}
 			result[table].NodeSchema[node][head] = schema
+			if IsReference(body) {
+				if _, ok := result[table].NodeRefs[node]; !ok {
+					result[table].NodeRefs[node] = map[string]bool{}
+				}
+				result[table].NodeRefs[node][head] = true
+			}
 		}
 	}
 	return result, nil
@@ -55,6 +55,33 @@ func TestParseMcf(t *testing.T) {
 							"typeOf":            "StatVarObservation",
 							"variableMeasured":  "Count_CriminalActivities_MurderAndNonNegligentManslaughter",
 						}},
+					NodeRefs: map[string]map[string]bool{
+						"E0": {"measurementMethod": true, "typeOf": true, "variableMeasured": true},
+						"E1": {"measurementMethod": true, "typeOf": true, "variableMeasured": true},
+					},
+				},
+			},
+		},
+		{
+			"energy.tmcf",
+			map[string]*TableSchema{
+				"UNEnergy": {
+					ColumnInfo: map[string][]*Column{
+						"Country": {{Node: "E0", Property: "observationAbout"}},
+						"Unit":    {{Node: "E0", Property: "unit"}},
+						"Method":  {{Node: "E0", Property: "measurementMethod"}},
+						"Value":   {{Node: "E0", Property: "value"}},
+					},
+					NodeSchema: map[string]map[string]string{
+						"E0": {
+							"observationDate":  "2019",
+							"typeOf":           "StatVarObservation",
+							"variableMeasured": "Annual_Generation_Electricity",
+						},
+					},
+					NodeRefs: map[string]map[string]bool{
+						"E0": {"typeOf": true, "variableMeasured": true},
+					},
 				},
 			},
 		},
@@ -73,3 +100,20 @@ func TestParseMcf(t *testing.T) {
 		}
 	}
 }
+
+func TestTrimValue(t *testing.T) {
+	for _, c := range []struct {
+		value string
+		want  string
+	}{
+		{"dcs:CensusPEPSurvey", "CensusPEPSurvey"},
+		{"dcid:geoId/06", "geoId/06"},
+		{"schema:Person", "Person"},
+		{`"P1M"`, "P1M"},
+		{"l:X", "l:X"},
+	} {
+		if got := TrimValue(c.value); got != c.want {
+			t.Errorf("TrimValue(%s) = %s, want %s", c.value, got, c.want)
+		}
+	}
+}
@@ -386,6 +386,195 @@ func (x *GetVersionResponse) GetGitHash() string {
 	return ""
 }
 
+// Empty request to get the ranking config.
+type GetRankingConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetRankingConfigRequest) Reset() {
+	*x = GetRankingConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_misc_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRankingConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRankingConfigRequest) ProtoMessage() {}
+
+func (x *GetRankingConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_misc_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRankingConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetRankingConfigRequest) Descriptor() ([]byte, []int) {
+	return file_misc_proto_rawDescGZIP(), []int{6}
+}
+
+// Get the effective ranking config.
+type GetRankingConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Version of the config, "default" for the built-in ranking.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Import group -> rank, where lower value ranks higher.
+	ImportGroupRanks map[string]int32                      `protobuf:"bytes,2,rep,name=import_group_ranks,json=importGroupRanks,proto3" json:"import_group_ranks,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	StatsRanking     []*GetRankingConfigResponse_StatsRank `protobuf:"bytes,3,rep,name=stats_ranking,json=statsRanking,proto3" json:"stats_ranking,omitempty"`
+}
+
+func (x *GetRankingConfigResponse) Reset() {
+	*x = GetRankingConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_misc_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRankingConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRankingConfigResponse) ProtoMessage() {}
+
+func (x *GetRankingConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_misc_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRankingConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetRankingConfigResponse) Descriptor() ([]byte, []int) {
+	return file_misc_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetRankingConfigResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetRankingConfigResponse) GetImportGroupRanks() map[string]int32 {
+	if x != nil {
+		return x.ImportGroupRanks
+	}
+	return nil
+}
+
+func (x *GetRankingConfigResponse) GetStatsRanking() []*GetRankingConfigResponse_StatsRank {
+	if x != nil {
+		return x.StatsRanking
+	}
+	return nil
+}
+
+type GetRankingConfigResponse_StatsRank struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ImportName string `protobuf:"bytes,1,opt,name=import_name,json=importName,proto3" json:"import_name,omitempty"`
+	// "*" matches any value.
+	MeasurementMethod string `protobuf:"bytes,2,opt,name=measurement_method,json=measurementMethod,proto3" json:"measurement_method,omitempty"`
+	// "*" matches any value.
+	ObservationPeriod string `protobuf:"bytes,3,opt,name=observation_period,json=observationPeriod,proto3" json:"observation_period,omitempty"`
+	// Lower value ranks higher.
+	Rank int32 `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Stat var or stat var group the rank applies to, or all stat vars when
+	// empty.
+	StatVar string `protobuf:"bytes,5,opt,name=stat_var,json=statVar,proto3" json:"stat_var,omitempty"`
+}
+
+func (x *GetRankingConfigResponse_StatsRank) Reset() {
+	*x = GetRankingConfigResponse_StatsRank{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_misc_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRankingConfigResponse_StatsRank) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRankingConfigResponse_StatsRank) ProtoMessage() {}
+
+func (x *GetRankingConfigResponse_StatsRank) ProtoReflect() protoreflect.Message {
+	mi := &file_misc_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRankingConfigResponse_StatsRank.ProtoReflect.Descriptor instead.
+func (*GetRankingConfigResponse_StatsRank) Descriptor() ([]byte, []int) {
+	return file_misc_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *GetRankingConfigResponse_StatsRank) GetImportName() string {
+	if x != nil {
+		return x.ImportName
+	}
+	return ""
+}
+
+func (x *GetRankingConfigResponse_StatsRank) GetMeasurementMethod() string {
+	if x != nil {
+		return x.MeasurementMethod
+	}
+	return ""
+}
+
+func (x *GetRankingConfigResponse_StatsRank) GetObservationPeriod() string {
+	if x != nil {
+		return x.ObservationPeriod
+	}
+	return ""
+}
+
+func (x *GetRankingConfigResponse_StatsRank) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *GetRankingConfigResponse_StatsRank) GetStatVar() string {
+	if x != nil {
+		return x.StatVar
+	}
+	return ""
+}
+
 var File_misc_proto protoreflect.FileDescriptor
 
 var file_misc_proto_rawDesc = []byte{
@@ -420,8 +609,41 @@ var file_misc_proto_rawDesc = []byte{
 	0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65,
 	0x73, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x4a, 0x04, 0x08, 0x03,
-	0x10, 0x04, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x10, 0x04, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0x19, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52,
+	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0xf6, 0x03, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x69,
+	0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x69, 0x0a, 0x12, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x10, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x61, 0x6e, 0x6b, 0x73, 0x12, 0x54, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x72,
+	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x0c, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x1a, 0xb9, 0x01, 0x0a, 0x09,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x1a, 0x43, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x61, 0x6e, 0x6b, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x09, 0x5a, 0x07,
+	0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -436,23 +658,29 @@ func file_misc_proto_rawDescGZIP() []byte {
 	return file_misc_proto_rawDescData
 }
 
-var file_misc_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_misc_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_misc_proto_goTypes = []interface{}{
-	(*SearchResultSection)(nil), // 0: datacommons.SearchResultSection
-	(*SearchEntityResult)(nil),  // 1: datacommons.SearchEntityResult
-	(*SearchRequest)(nil),       // 2: datacommons.SearchRequest
-	(*SearchResponse)(nil),      // 3: datacommons.SearchResponse
-	(*GetVersionRequest)(nil),   // 4: datacommons.GetVersionRequest
-	(*GetVersionResponse)(nil),  // 5: datacommons.GetVersionResponse
+	(*SearchResultSection)(nil),                // 0: datacommons.SearchResultSection
+	(*SearchEntityResult)(nil),                 // 1: datacommons.SearchEntityResult
+	(*SearchRequest)(nil),                      // 2: datacommons.SearchRequest
+	(*SearchResponse)(nil),                     // 3: datacommons.SearchResponse
+	(*GetVersionRequest)(nil),                  // 4: datacommons.GetVersionRequest
+	(*GetVersionResponse)(nil),                 // 5: datacommons.GetVersionResponse
+	(*GetRankingConfigRequest)(nil),            // 6: datacommons.GetRankingConfigRequest
+	(*GetRankingConfigResponse)(nil),           // 7: datacommons.GetRankingConfigResponse
+	(*GetRankingConfigResponse_StatsRank)(nil), // 8: datacommons.GetRankingConfigResponse.StatsRank
+	nil, // 9: datacommons.GetRankingConfigResponse.ImportGroupRanksEntry
 }
 var file_misc_proto_depIdxs = []int32{
 	1, // 0: datacommons.SearchResultSection.entity:type_name -> datacommons.SearchEntityResult
 	0, // 1: datacommons.SearchResponse.section:type_name -> datacommons.SearchResultSection
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	9, // 2: datacommons.GetRankingConfigResponse.import_group_ranks:type_name -> datacommons.GetRankingConfigResponse.ImportGroupRanksEntry
+	8, // 3: datacommons.GetRankingConfigResponse.stats_ranking:type_name -> datacommons.GetRankingConfigResponse.StatsRank
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_misc_proto_init() }
@@ -533,6 +761,42 @@ func file_misc_proto_init() {
 				return nil
 			}
 		}
+		file_misc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRankingConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_misc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRankingConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_misc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRankingConfigResponse_StatsRank); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -540,7 +804,7 @@ func file_misc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_misc_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
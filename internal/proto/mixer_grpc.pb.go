@@ -78,6 +78,8 @@ type MixerClient interface {
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
 	// Retrieves the version metadata.
 	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
+	// Retrieves the effective import group and source ranking, for debugging.
+	GetRankingConfig(ctx context.Context, in *GetRankingConfigRequest, opts ...grpc.CallOption) (*GetRankingConfigResponse, error)
 	// Give a list of place dcids, return all the statistical variables for each
 	// place.
 	GetPlaceStatsVar(ctx context.Context, in *GetPlaceStatsVarRequest, opts ...grpc.CallOption) (*GetPlaceStatsVarResponse, error)
@@ -337,6 +339,15 @@ func (c *mixerClient) GetVersion(ctx context.Context, in *GetVersionRequest, opt
 	return out, nil
 }
 
+func (c *mixerClient) GetRankingConfig(ctx context.Context, in *GetRankingConfigRequest, opts ...grpc.CallOption) (*GetRankingConfigResponse, error) {
+	out := new(GetRankingConfigResponse)
+	err := c.cc.Invoke(ctx, "/datacommons.Mixer/GetRankingConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mixerClient) GetPlaceStatsVar(ctx context.Context, in *GetPlaceStatsVarRequest, opts ...grpc.CallOption) (*GetPlaceStatsVarResponse, error) {
 	out := new(GetPlaceStatsVarResponse)
 	err := c.cc.Invoke(ctx, "/datacommons.Mixer/GetPlaceStatsVar", in, out, opts...)
@@ -730,6 +741,8 @@ type MixerServer interface {
 	Search(context.Context, *SearchRequest) (*SearchResponse, error)
 	// Retrieves the version metadata.
 	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
+	// Retrieves the effective import group and source ranking, for debugging.
+	GetRankingConfig(context.Context, *GetRankingConfigRequest) (*GetRankingConfigResponse, error)
 	// Give a list of place dcids, return all the statistical variables for each
 	// place.
 	GetPlaceStatsVar(context.Context, *GetPlaceStatsVarRequest) (*GetPlaceStatsVarResponse, error)
@@ -859,6 +872,9 @@ func (UnimplementedMixerServer) Search(context.Context, *SearchRequest) (*Search
 func (UnimplementedMixerServer) GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
 }
+func (UnimplementedMixerServer) GetRankingConfig(context.Context, *GetRankingConfigRequest) (*GetRankingConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRankingConfig not implemented")
+}
 func (UnimplementedMixerServer) GetPlaceStatsVar(context.Context, *GetPlaceStatsVarRequest) (*GetPlaceStatsVarResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPlaceStatsVar not implemented")
 }
@@ -1360,6 +1376,24 @@ func _Mixer_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mixer_GetRankingConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRankingConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MixerServer).GetRankingConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datacommons.Mixer/GetRankingConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MixerServer).GetRankingConfig(ctx, req.(*GetRankingConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Mixer_GetPlaceStatsVar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPlaceStatsVarRequest)
 	if err := dec(in); err != nil {
@@ -2117,6 +2151,10 @@ var Mixer_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetVersion",
 			Handler:    _Mixer_GetVersion_Handler,
 		},
+		{
+			MethodName: "GetRankingConfig",
+			Handler:    _Mixer_GetRankingConfig_Handler,
+		},
 		{
 			MethodName: "GetPlaceStatsVar",
 			Handler:    _Mixer_GetPlaceStatsVar_Handler,
@@ -34,6 +34,68 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// How a requested date matches the observation dates of a series.
+type DateMatch int32
+
+const (
+	// Only the requested date.
+	DateMatch_DATE_MATCH_EXACT DateMatch = 0
+	// The latest date in the year of the requested date, like "2020-07" for
+	// "2020" or "2020-03".
+	DateMatch_DATE_MATCH_SAME_YEAR DateMatch = 1
+	// The closest date on or before the requested date.
+	DateMatch_DATE_MATCH_NEAREST_BEFORE DateMatch = 2
+	// The closest date on or after the requested date.
+	DateMatch_DATE_MATCH_NEAREST_AFTER DateMatch = 3
+	// The closest date before or after the requested date.
+	DateMatch_DATE_MATCH_NEAREST DateMatch = 4
+)
+
+// Enum value maps for DateMatch.
+var (
+	DateMatch_name = map[int32]string{
+		0: "DATE_MATCH_EXACT",
+		1: "DATE_MATCH_SAME_YEAR",
+		2: "DATE_MATCH_NEAREST_BEFORE",
+		3: "DATE_MATCH_NEAREST_AFTER",
+		4: "DATE_MATCH_NEAREST",
+	}
+	DateMatch_value = map[string]int32{
+		"DATE_MATCH_EXACT":          0,
+		"DATE_MATCH_SAME_YEAR":      1,
+		"DATE_MATCH_NEAREST_BEFORE": 2,
+		"DATE_MATCH_NEAREST_AFTER":  3,
+		"DATE_MATCH_NEAREST":        4,
+	}
+)
+
+func (x DateMatch) Enum() *DateMatch {
+	p := new(DateMatch)
+	*p = x
+	return p
+}
+
+func (x DateMatch) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DateMatch) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_observations_proto_enumTypes[0].Descriptor()
+}
+
+func (DateMatch) Type() protoreflect.EnumType {
+	return &file_v1_observations_proto_enumTypes[0]
+}
+
+func (x DateMatch) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DateMatch.Descriptor instead.
+func (DateMatch) EnumDescriptor() ([]byte, []int) {
+	return file_v1_observations_proto_rawDescGZIP(), []int{0}
+}
+
 type TimeSeries struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -207,6 +269,182 @@ func (x *VariableObservations) GetObservationsByEntity() []*EntityObservations {
 	return nil
 }
 
+// Selects the facets of the observations. Each set field must match.
+type FacetFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ImportName        string `protobuf:"bytes,1,opt,name=import_name,json=importName,proto3" json:"import_name,omitempty"`
+	MeasurementMethod string `protobuf:"bytes,2,opt,name=measurement_method,json=measurementMethod,proto3" json:"measurement_method,omitempty"`
+	ObservationPeriod string `protobuf:"bytes,3,opt,name=observation_period,json=observationPeriod,proto3" json:"observation_period,omitempty"`
+	Unit              string `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	ScalingFactor     string `protobuf:"bytes,5,opt,name=scaling_factor,json=scalingFactor,proto3" json:"scaling_factor,omitempty"`
+	// Facet ids, as the keys of the facets in a previous response.
+	FacetIds []uint32 `protobuf:"varint,6,rep,packed,name=facet_ids,json=facetIds,proto3" json:"facet_ids,omitempty"`
+}
+
+func (x *FacetFilter) Reset() {
+	*x = FacetFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_observations_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FacetFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetFilter) ProtoMessage() {}
+
+func (x *FacetFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_observations_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetFilter.ProtoReflect.Descriptor instead.
+func (*FacetFilter) Descriptor() ([]byte, []int) {
+	return file_v1_observations_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FacetFilter) GetImportName() string {
+	if x != nil {
+		return x.ImportName
+	}
+	return ""
+}
+
+func (x *FacetFilter) GetMeasurementMethod() string {
+	if x != nil {
+		return x.MeasurementMethod
+	}
+	return ""
+}
+
+func (x *FacetFilter) GetObservationPeriod() string {
+	if x != nil {
+		return x.ObservationPeriod
+	}
+	return ""
+}
+
+func (x *FacetFilter) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *FacetFilter) GetScalingFactor() string {
+	if x != nil {
+		return x.ScalingFactor
+	}
+	return ""
+}
+
+func (x *FacetFilter) GetFacetIds() []uint32 {
+	if x != nil {
+		return x.FacetIds
+	}
+	return nil
+}
+
+// A variable computed from two variables as numerator / denominator * scaling,
+// like the unemployment rate from Count_Person_Unemployed and Count_Person.
+type DerivedVariable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the derived variable in the response, which can not be one of the
+	// requested variables.
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Numerator   string `protobuf:"bytes,2,opt,name=numerator,proto3" json:"numerator,omitempty"`
+	Denominator string `protobuf:"bytes,3,opt,name=denominator,proto3" json:"denominator,omitempty"`
+	// [Optional] Multiplier of the ratio, like 100 for a percentage. Defaults
+	// to 1.
+	Scaling float64 `protobuf:"fixed64,4,opt,name=scaling,proto3" json:"scaling,omitempty"`
+	// [Optional] How the date of a numerator observation matches the denominator
+	// dates. Defaults to the same date.
+	DenominatorDateMatch DateMatch `protobuf:"varint,5,opt,name=denominator_date_match,json=denominatorDateMatch,proto3,enum=datacommons.v1.DateMatch" json:"denominator_date_match,omitempty"`
+}
+
+func (x *DerivedVariable) Reset() {
+	*x = DerivedVariable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_observations_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DerivedVariable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DerivedVariable) ProtoMessage() {}
+
+func (x *DerivedVariable) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_observations_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DerivedVariable.ProtoReflect.Descriptor instead.
+func (*DerivedVariable) Descriptor() ([]byte, []int) {
+	return file_v1_observations_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DerivedVariable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DerivedVariable) GetNumerator() string {
+	if x != nil {
+		return x.Numerator
+	}
+	return ""
+}
+
+func (x *DerivedVariable) GetDenominator() string {
+	if x != nil {
+		return x.Denominator
+	}
+	return ""
+}
+
+func (x *DerivedVariable) GetScaling() float64 {
+	if x != nil {
+		return x.Scaling
+	}
+	return 0
+}
+
+func (x *DerivedVariable) GetDenominatorDateMatch() DateMatch {
+	if x != nil {
+		return x.DenominatorDateMatch
+	}
+	return DateMatch_DATE_MATCH_EXACT
+}
+
 type ObservationsPointRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -215,12 +453,18 @@ type ObservationsPointRequest struct {
 	Variable string `protobuf:"bytes,1,opt,name=variable,proto3" json:"variable,omitempty"`
 	Entity   string `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
 	Date     string `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	// [Optional] Only the observations of these facets.
+	FacetFilter *FacetFilter `protobuf:"bytes,4,opt,name=facet_filter,json=facetFilter,proto3" json:"facet_filter,omitempty"`
+	// [Optional] Unit to convert the values to, like "KilowattHour". The unit
+	// and scaling factor of the returned facets are rewritten accordingly. It is
+	// an error when the facet can not be converted, like one without a unit.
+	TargetUnit string `protobuf:"bytes,5,opt,name=target_unit,json=targetUnit,proto3" json:"target_unit,omitempty"`
 }
 
 func (x *ObservationsPointRequest) Reset() {
 	*x = ObservationsPointRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_observations_proto_msgTypes[3]
+		mi := &file_v1_observations_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -233,7 +477,7 @@ func (x *ObservationsPointRequest) String() string {
 func (*ObservationsPointRequest) ProtoMessage() {}
 
 func (x *ObservationsPointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_observations_proto_msgTypes[3]
+	mi := &file_v1_observations_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -246,7 +490,7 @@ func (x *ObservationsPointRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObservationsPointRequest.ProtoReflect.Descriptor instead.
 func (*ObservationsPointRequest) Descriptor() ([]byte, []int) {
-	return file_v1_observations_proto_rawDescGZIP(), []int{3}
+	return file_v1_observations_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ObservationsPointRequest) GetVariable() string {
@@ -270,6 +514,20 @@ func (x *ObservationsPointRequest) GetDate() string {
 	return ""
 }
 
+func (x *ObservationsPointRequest) GetFacetFilter() *FacetFilter {
+	if x != nil {
+		return x.FacetFilter
+	}
+	return nil
+}
+
+func (x *ObservationsPointRequest) GetTargetUnit() string {
+	if x != nil {
+		return x.TargetUnit
+	}
+	return ""
+}
+
 type BulkObservationsPointRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -279,12 +537,37 @@ type BulkObservationsPointRequest struct {
 	Variables []string `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty"`
 	Date      string   `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
 	AllFacets bool     `protobuf:"varint,4,opt,name=all_facets,json=allFacets,proto3" json:"all_facets,omitempty"`
+	// The date filters below pick the latest observation date that matches them,
+	// and can not be used with date.
+	// [Optional] Earliest observation date, inclusive. Dates are compared as
+	// ISO 8601 prefixes, so "2015" includes "2015-01".
+	StartDate string `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// [Optional] Latest observation date, inclusive. "2020" includes "2020-12".
+	EndDate string `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// [Optional] Only these observation dates.
+	Dates []string `protobuf:"bytes,7,rep,name=dates,proto3" json:"dates,omitempty"`
+	// [Optional] How date matches the observation dates, and requires date.
+	// Without all_facets, the date itself in any facet is preferred, and then
+	// the closest date across facets, with ties going to the higher ranked
+	// facet. The date of each point is the observation date used.
+	DateMatch DateMatch `protobuf:"varint,8,opt,name=date_match,json=dateMatch,proto3,enum=datacommons.v1.DateMatch" json:"date_match,omitempty"`
+	// [Optional] Only the observations of these facets.
+	FacetFilter *FacetFilter `protobuf:"bytes,9,opt,name=facet_filter,json=facetFilter,proto3" json:"facet_filter,omitempty"`
+	// [Optional] Variables computed from other variables, returned after the
+	// requested variables. Each point has the facets of both the numerator and
+	// the denominator. Can not be used with target_unit.
+	DerivedVariables []*DerivedVariable `protobuf:"bytes,10,rep,name=derived_variables,json=derivedVariables,proto3" json:"derived_variables,omitempty"`
+	// [Optional] Unit to convert the values to, like "KilowattHour". The unit
+	// and scaling factor of the returned facets are rewritten accordingly. It is
+	// an error when a facet can not be converted, like one without a unit. Can
+	// not be used with derived_variables.
+	TargetUnit string `protobuf:"bytes,11,opt,name=target_unit,json=targetUnit,proto3" json:"target_unit,omitempty"`
 }
 
 func (x *BulkObservationsPointRequest) Reset() {
 	*x = BulkObservationsPointRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_observations_proto_msgTypes[4]
+		mi := &file_v1_observations_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -297,7 +580,7 @@ func (x *BulkObservationsPointRequest) String() string {
 func (*BulkObservationsPointRequest) ProtoMessage() {}
 
 func (x *BulkObservationsPointRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_observations_proto_msgTypes[4]
+	mi := &file_v1_observations_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -310,7 +593,7 @@ func (x *BulkObservationsPointRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkObservationsPointRequest.ProtoReflect.Descriptor instead.
 func (*BulkObservationsPointRequest) Descriptor() ([]byte, []int) {
-	return file_v1_observations_proto_rawDescGZIP(), []int{4}
+	return file_v1_observations_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *BulkObservationsPointRequest) GetEntities() []string {
@@ -341,6 +624,55 @@ func (x *BulkObservationsPointRequest) GetAllFacets() bool {
 	return false
 }
 
+func (x *BulkObservationsPointRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *BulkObservationsPointRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *BulkObservationsPointRequest) GetDates() []string {
+	if x != nil {
+		return x.Dates
+	}
+	return nil
+}
+
+func (x *BulkObservationsPointRequest) GetDateMatch() DateMatch {
+	if x != nil {
+		return x.DateMatch
+	}
+	return DateMatch_DATE_MATCH_EXACT
+}
+
+func (x *BulkObservationsPointRequest) GetFacetFilter() *FacetFilter {
+	if x != nil {
+		return x.FacetFilter
+	}
+	return nil
+}
+
+func (x *BulkObservationsPointRequest) GetDerivedVariables() []*DerivedVariable {
+	if x != nil {
+		return x.DerivedVariables
+	}
+	return nil
+}
+
+func (x *BulkObservationsPointRequest) GetTargetUnit() string {
+	if x != nil {
+		return x.TargetUnit
+	}
+	return ""
+}
+
 type BulkObservationsPointResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -354,7 +686,7 @@ type BulkObservationsPointResponse struct {
 func (x *BulkObservationsPointResponse) Reset() {
 	*x = BulkObservationsPointResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_observations_proto_msgTypes[5]
+		mi := &file_v1_observations_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -367,7 +699,7 @@ func (x *BulkObservationsPointResponse) String() string {
 func (*BulkObservationsPointResponse) ProtoMessage() {}
 
 func (x *BulkObservationsPointResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_observations_proto_msgTypes[5]
+	mi := &file_v1_observations_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -380,7 +712,7 @@ func (x *BulkObservationsPointResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkObservationsPointResponse.ProtoReflect.Descriptor instead.
 func (*BulkObservationsPointResponse) Descriptor() ([]byte, []int) {
-	return file_v1_observations_proto_rawDescGZIP(), []int{5}
+	return file_v1_observations_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *BulkObservationsPointResponse) GetObservationsByVariable() []*VariableObservations {
@@ -414,12 +746,18 @@ type BulkObservationsPointLinkedRequest struct {
 	Date string `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
 	// [Optional] Whether to fetch data from all facets
 	AllFacets bool `protobuf:"varint,6,opt,name=all_facets,json=allFacets,proto3" json:"all_facets,omitempty"`
+	// [Optional] Only the observations of these facets.
+	FacetFilter *FacetFilter `protobuf:"bytes,7,opt,name=facet_filter,json=facetFilter,proto3" json:"facet_filter,omitempty"`
+	// [Optional] Unit to convert the values to, like "KilowattHour". The unit
+	// and scaling factor of the returned facets are rewritten accordingly. It is
+	// an error when a facet can not be converted, like one without a unit.
+	TargetUnit string `protobuf:"bytes,8,opt,name=target_unit,json=targetUnit,proto3" json:"target_unit,omitempty"`
 }
 
 func (x *BulkObservationsPointLinkedRequest) Reset() {
 	*x = BulkObservationsPointLinkedRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_observations_proto_msgTypes[6]
+		mi := &file_v1_observations_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -432,7 +770,7 @@ func (x *BulkObservationsPointLinkedRequest) String() string {
 func (*BulkObservationsPointLinkedRequest) ProtoMessage() {}
 
 func (x *BulkObservationsPointLinkedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_observations_proto_msgTypes[6]
+	mi := &file_v1_observations_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,7 +783,7 @@ func (x *BulkObservationsPointLinkedRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use BulkObservationsPointLinkedRequest.ProtoReflect.Descriptor instead.
 func (*BulkObservationsPointLinkedRequest) Descriptor() ([]byte, []int) {
-	return file_v1_observations_proto_rawDescGZIP(), []int{6}
+	return file_v1_observations_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *BulkObservationsPointLinkedRequest) GetEntityType() string {
@@ -490,6 +828,20 @@ func (x *BulkObservationsPointLinkedRequest) GetAllFacets() bool {
 	return false
 }
 
+func (x *BulkObservationsPointLinkedRequest) GetFacetFilter() *FacetFilter {
+	if x != nil {
+		return x.FacetFilter
+	}
+	return nil
+}
+
+func (x *BulkObservationsPointLinkedRequest) GetTargetUnit() string {
+	if x != nil {
+		return x.TargetUnit
+	}
+	return ""
+}
+
 // ------------  Observations Series
 type ObservationsSeriesRequest struct {
 	state         protoimpl.MessageState
@@ -498,12 +850,27 @@ type ObservationsSeriesRequest struct {
 
 	Variable string `protobuf:"bytes,1,opt,name=variable,proto3" json:"variable,omitempty"`
 	Entity   string `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	// [Optional] Earliest observation date, inclusive. Dates are compared as
+	// ISO 8601 prefixes, so "2015" includes "2015-01".
+	StartDate string `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// [Optional] Latest observation date, inclusive. "2020" includes "2020-12".
+	EndDate string `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// [Optional] Only these observation dates.
+	Dates []string `protobuf:"bytes,5,rep,name=dates,proto3" json:"dates,omitempty"`
+	// [Optional] Only the latest N observations that match the other filters.
+	LatestN int32 `protobuf:"varint,6,opt,name=latest_n,json=latestN,proto3" json:"latest_n,omitempty"`
+	// [Optional] Only the observations of these facets.
+	FacetFilter *FacetFilter `protobuf:"bytes,7,opt,name=facet_filter,json=facetFilter,proto3" json:"facet_filter,omitempty"`
+	// [Optional] Unit to convert the values to, like "KilowattHour". The unit
+	// and scaling factor of the returned facets are rewritten accordingly. It is
+	// an error when the facet can not be converted, like one without a unit.
+	TargetUnit string `protobuf:"bytes,8,opt,name=target_unit,json=targetUnit,proto3" json:"target_unit,omitempty"`
 }
 
 func (x *ObservationsSeriesRequest) Reset() {
 	*x = ObservationsSeriesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_observations_proto_msgTypes[7]
+		mi := &file_v1_observations_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -516,7 +883,7 @@ func (x *ObservationsSeriesRequest) String() string {
 func (*ObservationsSeriesRequest) ProtoMessage() {}
 
 func (x *ObservationsSeriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_observations_proto_msgTypes[7]
+	mi := &file_v1_observations_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -529,7 +896,7 @@ func (x *ObservationsSeriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObservationsSeriesRequest.ProtoReflect.Descriptor instead.
 func (*ObservationsSeriesRequest) Descriptor() ([]byte, []int) {
-	return file_v1_observations_proto_rawDescGZIP(), []int{7}
+	return file_v1_observations_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ObservationsSeriesRequest) GetVariable() string {
@@ -546,6 +913,48 @@ func (x *ObservationsSeriesRequest) GetEntity() string {
 	return ""
 }
 
+func (x *ObservationsSeriesRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ObservationsSeriesRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *ObservationsSeriesRequest) GetDates() []string {
+	if x != nil {
+		return x.Dates
+	}
+	return nil
+}
+
+func (x *ObservationsSeriesRequest) GetLatestN() int32 {
+	if x != nil {
+		return x.LatestN
+	}
+	return 0
+}
+
+func (x *ObservationsSeriesRequest) GetFacetFilter() *FacetFilter {
+	if x != nil {
+		return x.FacetFilter
+	}
+	return nil
+}
+
+func (x *ObservationsSeriesRequest) GetTargetUnit() string {
+	if x != nil {
+		return x.TargetUnit
+	}
+	return ""
+}
+
 type ObservationsSeriesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -558,7 +967,7 @@ type ObservationsSeriesResponse struct {
 func (x *ObservationsSeriesResponse) Reset() {
 	*x = ObservationsSeriesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_observations_proto_msgTypes[8]
+		mi := &file_v1_observations_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -571,7 +980,7 @@ func (x *ObservationsSeriesResponse) String() string {
 func (*ObservationsSeriesResponse) ProtoMessage() {}
 
 func (x *ObservationsSeriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_observations_proto_msgTypes[8]
+	mi := &file_v1_observations_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -584,7 +993,7 @@ func (x *ObservationsSeriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObservationsSeriesResponse.ProtoReflect.Descriptor instead.
 func (*ObservationsSeriesResponse) Descriptor() ([]byte, []int) {
-	return file_v1_observations_proto_rawDescGZIP(), []int{8}
+	return file_v1_observations_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ObservationsSeriesResponse) GetObservations() []*PointStat {
@@ -609,12 +1018,33 @@ type BulkObservationsSeriesRequest struct {
 	Entities  []string `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
 	Variables []string `protobuf:"bytes,2,rep,name=variables,proto3" json:"variables,omitempty"`
 	AllFacets bool     `protobuf:"varint,3,opt,name=all_facets,json=allFacets,proto3" json:"all_facets,omitempty"`
+	// [Optional] Earliest observation date, inclusive. Dates are compared as
+	// ISO 8601 prefixes, so "2015" includes "2015-01".
+	StartDate string `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// [Optional] Latest observation date, inclusive. "2020" includes "2020-12".
+	EndDate string `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// [Optional] Only these observation dates.
+	Dates []string `protobuf:"bytes,6,rep,name=dates,proto3" json:"dates,omitempty"`
+	// [Optional] Only the latest N observations of each series that match the
+	// other filters.
+	LatestN int32 `protobuf:"varint,7,opt,name=latest_n,json=latestN,proto3" json:"latest_n,omitempty"`
+	// [Optional] Only the observations of these facets.
+	FacetFilter *FacetFilter `protobuf:"bytes,8,opt,name=facet_filter,json=facetFilter,proto3" json:"facet_filter,omitempty"`
+	// [Optional] Variables computed from other variables, returned after the
+	// requested variables. Each point has the facets of both the numerator and
+	// the denominator. Can not be used with target_unit.
+	DerivedVariables []*DerivedVariable `protobuf:"bytes,9,rep,name=derived_variables,json=derivedVariables,proto3" json:"derived_variables,omitempty"`
+	// [Optional] Unit to convert the values to, like "KilowattHour". The unit
+	// and scaling factor of the returned facets are rewritten accordingly. It is
+	// an error when a facet can not be converted, like one without a unit. Can
+	// not be used with derived_variables.
+	TargetUnit string `protobuf:"bytes,10,opt,name=target_unit,json=targetUnit,proto3" json:"target_unit,omitempty"`
 }
 
 func (x *BulkObservationsSeriesRequest) Reset() {
 	*x = BulkObservationsSeriesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_observations_proto_msgTypes[9]
+		mi := &file_v1_observations_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -627,7 +1057,7 @@ func (x *BulkObservationsSeriesRequest) String() string {
 func (*BulkObservationsSeriesRequest) ProtoMessage() {}
 
 func (x *BulkObservationsSeriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_observations_proto_msgTypes[9]
+	mi := &file_v1_observations_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -640,7 +1070,7 @@ func (x *BulkObservationsSeriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkObservationsSeriesRequest.ProtoReflect.Descriptor instead.
 func (*BulkObservationsSeriesRequest) Descriptor() ([]byte, []int) {
-	return file_v1_observations_proto_rawDescGZIP(), []int{9}
+	return file_v1_observations_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *BulkObservationsSeriesRequest) GetEntities() []string {
@@ -664,6 +1094,55 @@ func (x *BulkObservationsSeriesRequest) GetAllFacets() bool {
 	return false
 }
 
+func (x *BulkObservationsSeriesRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *BulkObservationsSeriesRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *BulkObservationsSeriesRequest) GetDates() []string {
+	if x != nil {
+		return x.Dates
+	}
+	return nil
+}
+
+func (x *BulkObservationsSeriesRequest) GetLatestN() int32 {
+	if x != nil {
+		return x.LatestN
+	}
+	return 0
+}
+
+func (x *BulkObservationsSeriesRequest) GetFacetFilter() *FacetFilter {
+	if x != nil {
+		return x.FacetFilter
+	}
+	return nil
+}
+
+func (x *BulkObservationsSeriesRequest) GetDerivedVariables() []*DerivedVariable {
+	if x != nil {
+		return x.DerivedVariables
+	}
+	return nil
+}
+
+func (x *BulkObservationsSeriesRequest) GetTargetUnit() string {
+	if x != nil {
+		return x.TargetUnit
+	}
+	return ""
+}
+
 type BulkObservationsSeriesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -676,7 +1155,7 @@ type BulkObservationsSeriesResponse struct {
 func (x *BulkObservationsSeriesResponse) Reset() {
 	*x = BulkObservationsSeriesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_observations_proto_msgTypes[10]
+		mi := &file_v1_observations_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -689,7 +1168,7 @@ func (x *BulkObservationsSeriesResponse) String() string {
 func (*BulkObservationsSeriesResponse) ProtoMessage() {}
 
 func (x *BulkObservationsSeriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_observations_proto_msgTypes[10]
+	mi := &file_v1_observations_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,7 +1181,7 @@ func (x *BulkObservationsSeriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BulkObservationsSeriesResponse.ProtoReflect.Descriptor instead.
 func (*BulkObservationsSeriesResponse) Descriptor() ([]byte, []int) {
-	return file_v1_observations_proto_rawDescGZIP(), []int{10}
+	return file_v1_observations_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *BulkObservationsSeriesResponse) GetObservationsByVariable() []*VariableObservations {
@@ -734,12 +1213,18 @@ type BulkObservationsSeriesLinkedRequest struct {
 	Variables []string `protobuf:"bytes,4,rep,name=variables,proto3" json:"variables,omitempty"`
 	// [Optional] Whether to fetch data from all facets
 	AllFacets bool `protobuf:"varint,5,opt,name=all_facets,json=allFacets,proto3" json:"all_facets,omitempty"`
+	// [Optional] Only the observations of these facets.
+	FacetFilter *FacetFilter `protobuf:"bytes,6,opt,name=facet_filter,json=facetFilter,proto3" json:"facet_filter,omitempty"`
+	// [Optional] Unit to convert the values to, like "KilowattHour". The unit
+	// and scaling factor of the returned facets are rewritten accordingly. It is
+	// an error when a facet can not be converted, like one without a unit.
+	TargetUnit string `protobuf:"bytes,7,opt,name=target_unit,json=targetUnit,proto3" json:"target_unit,omitempty"`
 }
 
 func (x *BulkObservationsSeriesLinkedRequest) Reset() {
 	*x = BulkObservationsSeriesLinkedRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_observations_proto_msgTypes[11]
+		mi := &file_v1_observations_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -752,7 +1237,7 @@ func (x *BulkObservationsSeriesLinkedRequest) String() string {
 func (*BulkObservationsSeriesLinkedRequest) ProtoMessage() {}
 
 func (x *BulkObservationsSeriesLinkedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_observations_proto_msgTypes[11]
+	mi := &file_v1_observations_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -765,7 +1250,7 @@ func (x *BulkObservationsSeriesLinkedRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use BulkObservationsSeriesLinkedRequest.ProtoReflect.Descriptor instead.
 func (*BulkObservationsSeriesLinkedRequest) Descriptor() ([]byte, []int) {
-	return file_v1_observations_proto_rawDescGZIP(), []int{11}
+	return file_v1_observations_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *BulkObservationsSeriesLinkedRequest) GetEntityType() string {
@@ -803,6 +1288,20 @@ func (x *BulkObservationsSeriesLinkedRequest) GetAllFacets() bool {
 	return false
 }
 
+func (x *BulkObservationsSeriesLinkedRequest) GetFacetFilter() *FacetFilter {
+	if x != nil {
+		return x.FacetFilter
+	}
+	return nil
+}
+
+func (x *BulkObservationsSeriesLinkedRequest) GetTargetUnit() string {
+	if x != nil {
+		return x.TargetUnit
+	}
+	return ""
+}
+
 var File_v1_observations_proto protoreflect.FileDescriptor
 
 var file_v1_observations_proto_rawDesc = []byte{
@@ -834,110 +1333,215 @@ var file_v1_observations_proto_rawDesc = []byte{
 	0x22, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31,
 	0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x73, 0x52, 0x14, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x62, 0x0a, 0x18, 0x4f, 0x62, 0x73,
-	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x8b, 0x01,
-	0x0a, 0x1c, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61,
-	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x76,
-	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x61, 0x6c, 0x6c, 0x5f, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x61, 0x6c, 0x6c, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x22, 0xa8, 0x02, 0x0a, 0x1d,
+	0x73, 0x42, 0x79, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xe4, 0x01, 0x0a, 0x0b, 0x46, 0x61,
+	0x63, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x61, 0x63, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x66, 0x61, 0x63, 0x65, 0x74, 0x49, 0x64, 0x73,
+	0x22, 0xd0, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x56, 0x61, 0x72, 0x69,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x75, 0x6d,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69,
+	0x6e, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x63, 0x61, 0x6c,
+	0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x73, 0x63, 0x61, 0x6c, 0x69,
+	0x6e, 0x67, 0x12, 0x4f, 0x0a, 0x16, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x14, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x74, 0x63, 0x68, 0x22, 0xc3, 0x01, 0x0a, 0x18, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x66, 0x61, 0x63, 0x65,
+	0x74, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x61, 0x63, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x66, 0x61, 0x63,
+	0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x22, 0xc4, 0x03, 0x0a, 0x1c, 0x42, 0x75,
+	0x6c, 0x6b, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x5f,
+	0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c,
+	0x6c, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74,
+	0x65, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x09, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x12, 0x3e, 0x0a, 0x0c, 0x66, 0x61, 0x63, 0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x66, 0x61, 0x63, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x4c, 0x0a, 0x11, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65,
+	0x72, 0x69, 0x76, 0x65, 0x64, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x10, 0x64,
+	0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x6e, 0x69, 0x74,
+	0x22, 0xa8, 0x02, 0x0a, 0x1d, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5e, 0x0a, 0x18, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x16, 0x6f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x51, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x39, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66,
+	0x61, 0x63, 0x65, 0x74, 0x73, 0x1a, 0x54, 0x0a, 0x0b, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc5, 0x02, 0x0a, 0x22,
 	0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a,
-	0x18, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x62, 0x79,
-	0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31,
-	0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x16, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x42, 0x79, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x51, 0x0a,
-	0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42,
-	0x75, 0x6c, 0x6b, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50,
-	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x61, 0x63,
-	0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73,
-	0x1a, 0x54, 0x0a, 0x0b, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe4, 0x01, 0x0a, 0x22, 0x42, 0x75, 0x6c, 0x6b, 0x4f,
-	0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74,
-	0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23,
-	0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x45, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x70, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x69,
-	0x6e, 0x6b, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09,
-	0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1d,
-	0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x5f, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x22, 0x4f, 0x0a,
-	0x19, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x65, 0x72,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61,
-	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x61,
-	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x89,
-	0x01, 0x0a, 0x1a, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53,
-	0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a,
-	0x0c, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x73, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x52, 0x0c, 0x6f, 0x62, 0x73,
-	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x66, 0x61, 0x63,
-	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x52, 0x05, 0x66, 0x61, 0x63, 0x65, 0x74, 0x22, 0x78, 0x0a, 0x1d, 0x42, 0x75,
-	0x6c, 0x6b, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x65,
-	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61,
-	0x62, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69,
-	0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x5f, 0x66, 0x61, 0x63,
-	0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x46, 0x61,
-	0x63, 0x65, 0x74, 0x73, 0x22, 0xaa, 0x02, 0x0a, 0x1e, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x62, 0x73,
-	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x18, 0x6f, 0x62, 0x73, 0x65, 0x72,
-	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61,
-	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61,
-	0x62, 0x6c, 0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x16, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x56,
-	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x52, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x62, 0x73,
-	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x1a, 0x54, 0x0a, 0x0b, 0x46,
-	0x61, 0x63, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x6b,
+	0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x69, 0x6e, 0x6b,
+	0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x5f, 0x66, 0x61, 0x63, 0x65, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x46, 0x61, 0x63, 0x65,
+	0x74, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x66, 0x61, 0x63, 0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x66, 0x61, 0x63, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x6e, 0x69,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55,
+	0x6e, 0x69, 0x74, 0x22, 0x9b, 0x02, 0x0a, 0x19, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x5f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x4e,
+	0x12, 0x3e, 0x0a, 0x0c, 0x66, 0x61, 0x63, 0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x0b, 0x66, 0x61, 0x63, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x6e, 0x69,
+	0x74, 0x22, 0x89, 0x01, 0x0a, 0x1a, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3a, 0x0a, 0x0c, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x52, 0x0c,
+	0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x05,
+	0x66, 0x61, 0x63, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61,
 	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0xd1, 0x01, 0x0a, 0x23, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x6e, 0x6b,
-	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x69,
-	0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
-	0x27, 0x0a, 0x0f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
-	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64,
-	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69,
-	0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x72,
-	0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x5f, 0x66, 0x61,
-	0x63, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x46,
-	0x61, 0x63, 0x65, 0x74, 0x73, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x66, 0x61, 0x63, 0x65, 0x74, 0x22, 0x92, 0x03,
+	0x0a, 0x1d, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x6c,
+	0x5f, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61,
+	0x6c, 0x6c, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x5f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x4e, 0x12, 0x3e, 0x0a, 0x0c, 0x66, 0x61, 0x63, 0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x66, 0x61, 0x63, 0x65, 0x74, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x11, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x10, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x6e,
+	0x69, 0x74, 0x22, 0xaa, 0x02, 0x0a, 0x1e, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x18, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c,
+	0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x16, 0x6f,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x79, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x52, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x66, 0x61, 0x63, 0x65, 0x74, 0x73, 0x1a, 0x54, 0x0a, 0x0b, 0x46, 0x61, 0x63,
+	0x65, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xb2, 0x02, 0x0a, 0x23, 0x42, 0x75, 0x6c, 0x6b, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x69, 0x6e, 0x6b,
+	0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x27, 0x0a,
+	0x0f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x5f, 0x66, 0x61, 0x63, 0x65,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x46, 0x61, 0x63,
+	0x65, 0x74, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x66, 0x61, 0x63, 0x65, 0x74, 0x5f, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x63, 0x65, 0x74,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x66, 0x61, 0x63, 0x65, 0x74, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x6e,
+	0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x55, 0x6e, 0x69, 0x74, 0x2a, 0x90, 0x01, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48,
+	0x5f, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x41, 0x54, 0x45,
+	0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x41, 0x4d, 0x45, 0x5f, 0x59, 0x45, 0x41, 0x52,
+	0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48,
+	0x5f, 0x4e, 0x45, 0x41, 0x52, 0x45, 0x53, 0x54, 0x5f, 0x42, 0x45, 0x46, 0x4f, 0x52, 0x45, 0x10,
+	0x02, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f,
+	0x4e, 0x45, 0x41, 0x52, 0x45, 0x53, 0x54, 0x5f, 0x41, 0x46, 0x54, 0x45, 0x52, 0x10, 0x03, 0x12,
+	0x16, 0x0a, 0x12, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4e, 0x45,
+	0x41, 0x52, 0x45, 0x53, 0x54, 0x10, 0x04, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -952,43 +1556,57 @@ func file_v1_observations_proto_rawDescGZIP() []byte {
 	return file_v1_observations_proto_rawDescData
 }
 
-var file_v1_observations_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_v1_observations_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_v1_observations_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_v1_observations_proto_goTypes = []interface{}{
-	(*TimeSeries)(nil),                          // 0: datacommons.v1.TimeSeries
-	(*EntityObservations)(nil),                  // 1: datacommons.v1.EntityObservations
-	(*VariableObservations)(nil),                // 2: datacommons.v1.VariableObservations
-	(*ObservationsPointRequest)(nil),            // 3: datacommons.v1.ObservationsPointRequest
-	(*BulkObservationsPointRequest)(nil),        // 4: datacommons.v1.BulkObservationsPointRequest
-	(*BulkObservationsPointResponse)(nil),       // 5: datacommons.v1.BulkObservationsPointResponse
-	(*BulkObservationsPointLinkedRequest)(nil),  // 6: datacommons.v1.BulkObservationsPointLinkedRequest
-	(*ObservationsSeriesRequest)(nil),           // 7: datacommons.v1.ObservationsSeriesRequest
-	(*ObservationsSeriesResponse)(nil),          // 8: datacommons.v1.ObservationsSeriesResponse
-	(*BulkObservationsSeriesRequest)(nil),       // 9: datacommons.v1.BulkObservationsSeriesRequest
-	(*BulkObservationsSeriesResponse)(nil),      // 10: datacommons.v1.BulkObservationsSeriesResponse
-	(*BulkObservationsSeriesLinkedRequest)(nil), // 11: datacommons.v1.BulkObservationsSeriesLinkedRequest
-	nil,                  // 12: datacommons.v1.BulkObservationsPointResponse.FacetsEntry
-	nil,                  // 13: datacommons.v1.BulkObservationsSeriesResponse.FacetsEntry
-	(*PointStat)(nil),    // 14: datacommons.PointStat
-	(*StatMetadata)(nil), // 15: datacommons.StatMetadata
+	(DateMatch)(0),                              // 0: datacommons.v1.DateMatch
+	(*TimeSeries)(nil),                          // 1: datacommons.v1.TimeSeries
+	(*EntityObservations)(nil),                  // 2: datacommons.v1.EntityObservations
+	(*VariableObservations)(nil),                // 3: datacommons.v1.VariableObservations
+	(*FacetFilter)(nil),                         // 4: datacommons.v1.FacetFilter
+	(*DerivedVariable)(nil),                     // 5: datacommons.v1.DerivedVariable
+	(*ObservationsPointRequest)(nil),            // 6: datacommons.v1.ObservationsPointRequest
+	(*BulkObservationsPointRequest)(nil),        // 7: datacommons.v1.BulkObservationsPointRequest
+	(*BulkObservationsPointResponse)(nil),       // 8: datacommons.v1.BulkObservationsPointResponse
+	(*BulkObservationsPointLinkedRequest)(nil),  // 9: datacommons.v1.BulkObservationsPointLinkedRequest
+	(*ObservationsSeriesRequest)(nil),           // 10: datacommons.v1.ObservationsSeriesRequest
+	(*ObservationsSeriesResponse)(nil),          // 11: datacommons.v1.ObservationsSeriesResponse
+	(*BulkObservationsSeriesRequest)(nil),       // 12: datacommons.v1.BulkObservationsSeriesRequest
+	(*BulkObservationsSeriesResponse)(nil),      // 13: datacommons.v1.BulkObservationsSeriesResponse
+	(*BulkObservationsSeriesLinkedRequest)(nil), // 14: datacommons.v1.BulkObservationsSeriesLinkedRequest
+	nil,                  // 15: datacommons.v1.BulkObservationsPointResponse.FacetsEntry
+	nil,                  // 16: datacommons.v1.BulkObservationsSeriesResponse.FacetsEntry
+	(*PointStat)(nil),    // 17: datacommons.PointStat
+	(*StatMetadata)(nil), // 18: datacommons.StatMetadata
 }
 var file_v1_observations_proto_depIdxs = []int32{
-	14, // 0: datacommons.v1.TimeSeries.series:type_name -> datacommons.PointStat
-	14, // 1: datacommons.v1.EntityObservations.points_by_facet:type_name -> datacommons.PointStat
-	0,  // 2: datacommons.v1.EntityObservations.series_by_facet:type_name -> datacommons.v1.TimeSeries
-	1,  // 3: datacommons.v1.VariableObservations.observations_by_entity:type_name -> datacommons.v1.EntityObservations
-	2,  // 4: datacommons.v1.BulkObservationsPointResponse.observations_by_variable:type_name -> datacommons.v1.VariableObservations
-	12, // 5: datacommons.v1.BulkObservationsPointResponse.facets:type_name -> datacommons.v1.BulkObservationsPointResponse.FacetsEntry
-	14, // 6: datacommons.v1.ObservationsSeriesResponse.observations:type_name -> datacommons.PointStat
-	15, // 7: datacommons.v1.ObservationsSeriesResponse.facet:type_name -> datacommons.StatMetadata
-	2,  // 8: datacommons.v1.BulkObservationsSeriesResponse.observations_by_variable:type_name -> datacommons.v1.VariableObservations
-	13, // 9: datacommons.v1.BulkObservationsSeriesResponse.facets:type_name -> datacommons.v1.BulkObservationsSeriesResponse.FacetsEntry
-	15, // 10: datacommons.v1.BulkObservationsPointResponse.FacetsEntry.value:type_name -> datacommons.StatMetadata
-	15, // 11: datacommons.v1.BulkObservationsSeriesResponse.FacetsEntry.value:type_name -> datacommons.StatMetadata
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	17, // 0: datacommons.v1.TimeSeries.series:type_name -> datacommons.PointStat
+	17, // 1: datacommons.v1.EntityObservations.points_by_facet:type_name -> datacommons.PointStat
+	1,  // 2: datacommons.v1.EntityObservations.series_by_facet:type_name -> datacommons.v1.TimeSeries
+	2,  // 3: datacommons.v1.VariableObservations.observations_by_entity:type_name -> datacommons.v1.EntityObservations
+	0,  // 4: datacommons.v1.DerivedVariable.denominator_date_match:type_name -> datacommons.v1.DateMatch
+	4,  // 5: datacommons.v1.ObservationsPointRequest.facet_filter:type_name -> datacommons.v1.FacetFilter
+	0,  // 6: datacommons.v1.BulkObservationsPointRequest.date_match:type_name -> datacommons.v1.DateMatch
+	4,  // 7: datacommons.v1.BulkObservationsPointRequest.facet_filter:type_name -> datacommons.v1.FacetFilter
+	5,  // 8: datacommons.v1.BulkObservationsPointRequest.derived_variables:type_name -> datacommons.v1.DerivedVariable
+	3,  // 9: datacommons.v1.BulkObservationsPointResponse.observations_by_variable:type_name -> datacommons.v1.VariableObservations
+	15, // 10: datacommons.v1.BulkObservationsPointResponse.facets:type_name -> datacommons.v1.BulkObservationsPointResponse.FacetsEntry
+	4,  // 11: datacommons.v1.BulkObservationsPointLinkedRequest.facet_filter:type_name -> datacommons.v1.FacetFilter
+	4,  // 12: datacommons.v1.ObservationsSeriesRequest.facet_filter:type_name -> datacommons.v1.FacetFilter
+	17, // 13: datacommons.v1.ObservationsSeriesResponse.observations:type_name -> datacommons.PointStat
+	18, // 14: datacommons.v1.ObservationsSeriesResponse.facet:type_name -> datacommons.StatMetadata
+	4,  // 15: datacommons.v1.BulkObservationsSeriesRequest.facet_filter:type_name -> datacommons.v1.FacetFilter
+	5,  // 16: datacommons.v1.BulkObservationsSeriesRequest.derived_variables:type_name -> datacommons.v1.DerivedVariable
+	3,  // 17: datacommons.v1.BulkObservationsSeriesResponse.observations_by_variable:type_name -> datacommons.v1.VariableObservations
+	16, // 18: datacommons.v1.BulkObservationsSeriesResponse.facets:type_name -> datacommons.v1.BulkObservationsSeriesResponse.FacetsEntry
+	4,  // 19: datacommons.v1.BulkObservationsSeriesLinkedRequest.facet_filter:type_name -> datacommons.v1.FacetFilter
+	18, // 20: datacommons.v1.BulkObservationsPointResponse.FacetsEntry.value:type_name -> datacommons.StatMetadata
+	18, // 21: datacommons.v1.BulkObservationsSeriesResponse.FacetsEntry.value:type_name -> datacommons.StatMetadata
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_v1_observations_proto_init() }
@@ -1035,7 +1653,7 @@ func file_v1_observations_proto_init() {
 			}
 		}
 		file_v1_observations_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ObservationsPointRequest); i {
+			switch v := v.(*FacetFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1047,7 +1665,7 @@ func file_v1_observations_proto_init() {
 			}
 		}
 		file_v1_observations_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkObservationsPointRequest); i {
+			switch v := v.(*DerivedVariable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1059,7 +1677,7 @@ func file_v1_observations_proto_init() {
 			}
 		}
 		file_v1_observations_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkObservationsPointResponse); i {
+			switch v := v.(*ObservationsPointRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1071,7 +1689,7 @@ func file_v1_observations_proto_init() {
 			}
 		}
 		file_v1_observations_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkObservationsPointLinkedRequest); i {
+			switch v := v.(*BulkObservationsPointRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1083,7 +1701,7 @@ func file_v1_observations_proto_init() {
 			}
 		}
 		file_v1_observations_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ObservationsSeriesRequest); i {
+			switch v := v.(*BulkObservationsPointResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1095,7 +1713,7 @@ func file_v1_observations_proto_init() {
 			}
 		}
 		file_v1_observations_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ObservationsSeriesResponse); i {
+			switch v := v.(*BulkObservationsPointLinkedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1107,7 +1725,7 @@ func file_v1_observations_proto_init() {
 			}
 		}
 		file_v1_observations_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkObservationsSeriesRequest); i {
+			switch v := v.(*ObservationsSeriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1119,7 +1737,7 @@ func file_v1_observations_proto_init() {
 			}
 		}
 		file_v1_observations_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BulkObservationsSeriesResponse); i {
+			switch v := v.(*ObservationsSeriesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1131,6 +1749,30 @@ func file_v1_observations_proto_init() {
 			}
 		}
 		file_v1_observations_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkObservationsSeriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_observations_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkObservationsSeriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_observations_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BulkObservationsSeriesLinkedRequest); i {
 			case 0:
 				return &v.state
@@ -1148,13 +1790,14 @@ func file_v1_observations_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_observations_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   14,
+			NumEnums:      1,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_v1_observations_proto_goTypes,
 		DependencyIndexes: file_v1_observations_proto_depIdxs,
+		EnumInfos:         file_v1_observations_proto_enumTypes,
 		MessageInfos:      file_v1_observations_proto_msgTypes,
 	}.Build()
 	File_v1_observations_proto = out.File
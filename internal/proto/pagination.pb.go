@@ -112,6 +112,9 @@ type CursorGroup struct {
 	// Entity DCID or other information that identifies the CursorGroup.
 	Keys    []string  `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
 	Cursors []*Cursor `protobuf:"bytes,2,rep,name=cursors,proto3" json:"cursors,omitempty"`
+	// The position of the next value of the private import in memdb, which is
+	// read after the values of all the import groups, starts from 0.
+	MemdbItem int32 `protobuf:"varint,3,opt,name=memdb_item,json=memdbItem,proto3" json:"memdb_item,omitempty"`
 }
 
 func (x *CursorGroup) Reset() {
@@ -160,6 +163,13 @@ func (x *CursorGroup) GetCursors() []*Cursor {
 	return nil
 }
 
+func (x *CursorGroup) GetMemdbItem() int32 {
+	if x != nil {
+		return x.MemdbItem
+	}
+	return 0
+}
+
 // Represents the cursor information of one pagination request.
 // Each cursor group corresponds to the cursor information of one requested
 // entity. There are multiple cursor groups for bulk APIs.
@@ -169,6 +179,9 @@ type PaginationInfo struct {
 	unknownFields protoimpl.UnknownFields
 
 	CursorGroups []*CursorGroup `protobuf:"bytes,1,rep,name=cursor_groups,json=cursorGroups,proto3" json:"cursor_groups,omitempty"`
+	// Import groups of the tables that the cursors index into, in order. Empty
+	// for tokens created before the import groups were recorded.
+	ImportGroups []string `protobuf:"bytes,2,rep,name=import_groups,json=importGroups,proto3" json:"import_groups,omitempty"`
 }
 
 func (x *PaginationInfo) Reset() {
@@ -210,6 +223,13 @@ func (x *PaginationInfo) GetCursorGroups() []*CursorGroup {
 	return nil
 }
 
+func (x *PaginationInfo) GetImportGroups() []string {
+	if x != nil {
+		return x.ImportGroups
+	}
+	return nil
+}
+
 var File_v1_pagination_proto protoreflect.FileDescriptor
 
 var file_v1_pagination_proto_rawDesc = []byte{
@@ -220,19 +240,23 @@ var file_v1_pagination_proto_rawDesc = []byte{
 	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x72, 0x6f,
 	0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
 	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x53, 0x0a, 0x0b, 0x43, 0x75,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x72, 0x0a, 0x0b, 0x43, 0x75,
 	0x72, 0x73, 0x6f, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79,
 	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x30, 0x0a,
 	0x07, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
 	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x07, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x73, 0x22,
-	0x52, 0x0a, 0x0e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x40, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x07, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x6d, 0x64, 0x62, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x65, 0x6d, 0x64, 0x62, 0x49, 0x74, 0x65, 0x6d, 0x22, 0x77,
+	0x0a, 0x0e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x40, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
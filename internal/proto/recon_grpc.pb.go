@@ -28,6 +28,10 @@ type ReconClient interface {
 	ResolveEntities(ctx context.Context, in *ResolveEntitiesRequest, opts ...grpc.CallOption) (*ResolveEntitiesResponse, error)
 	// Resolve a list of places, given their latitude and longitude coordinates.
 	ResolveCoordinates(ctx context.Context, in *ResolveCoordinatesRequest, opts ...grpc.CallOption) (*ResolveCoordinatesResponse, error)
+	// Resolve the places that intersect or are contained in a GeoJSON polygon.
+	ResolvePolygon(ctx context.Context, in *ResolvePolygonRequest, opts ...grpc.CallOption) (*ResolvePolygonResponse, error)
+	// Resolve the places that intersect or are contained in a bounding box.
+	ResolveBoundingBox(ctx context.Context, in *ResolveBoundingBoxRequest, opts ...grpc.CallOption) (*ResolvePolygonResponse, error)
 	// Resolve a list of IDs, given the input prop and output prop.
 	ResolveIds(ctx context.Context, in *ResolveIdsRequest, opts ...grpc.CallOption) (*ResolveIdsResponse, error)
 }
@@ -67,6 +71,24 @@ func (c *reconClient) ResolveCoordinates(ctx context.Context, in *ResolveCoordin
 	return out, nil
 }
 
+func (c *reconClient) ResolvePolygon(ctx context.Context, in *ResolvePolygonRequest, opts ...grpc.CallOption) (*ResolvePolygonResponse, error) {
+	out := new(ResolvePolygonResponse)
+	err := c.cc.Invoke(ctx, "/datacommons.Recon/ResolvePolygon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reconClient) ResolveBoundingBox(ctx context.Context, in *ResolveBoundingBoxRequest, opts ...grpc.CallOption) (*ResolvePolygonResponse, error) {
+	out := new(ResolvePolygonResponse)
+	err := c.cc.Invoke(ctx, "/datacommons.Recon/ResolveBoundingBox", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *reconClient) ResolveIds(ctx context.Context, in *ResolveIdsRequest, opts ...grpc.CallOption) (*ResolveIdsResponse, error) {
 	out := new(ResolveIdsResponse)
 	err := c.cc.Invoke(ctx, "/datacommons.Recon/ResolveIds", in, out, opts...)
@@ -86,6 +108,10 @@ type ReconServer interface {
 	ResolveEntities(context.Context, *ResolveEntitiesRequest) (*ResolveEntitiesResponse, error)
 	// Resolve a list of places, given their latitude and longitude coordinates.
 	ResolveCoordinates(context.Context, *ResolveCoordinatesRequest) (*ResolveCoordinatesResponse, error)
+	// Resolve the places that intersect or are contained in a GeoJSON polygon.
+	ResolvePolygon(context.Context, *ResolvePolygonRequest) (*ResolvePolygonResponse, error)
+	// Resolve the places that intersect or are contained in a bounding box.
+	ResolveBoundingBox(context.Context, *ResolveBoundingBoxRequest) (*ResolvePolygonResponse, error)
 	// Resolve a list of IDs, given the input prop and output prop.
 	ResolveIds(context.Context, *ResolveIdsRequest) (*ResolveIdsResponse, error)
 }
@@ -103,6 +129,12 @@ func (UnimplementedReconServer) ResolveEntities(context.Context, *ResolveEntitie
 func (UnimplementedReconServer) ResolveCoordinates(context.Context, *ResolveCoordinatesRequest) (*ResolveCoordinatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResolveCoordinates not implemented")
 }
+func (UnimplementedReconServer) ResolvePolygon(context.Context, *ResolvePolygonRequest) (*ResolvePolygonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolvePolygon not implemented")
+}
+func (UnimplementedReconServer) ResolveBoundingBox(context.Context, *ResolveBoundingBoxRequest) (*ResolvePolygonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveBoundingBox not implemented")
+}
 func (UnimplementedReconServer) ResolveIds(context.Context, *ResolveIdsRequest) (*ResolveIdsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResolveIds not implemented")
 }
@@ -172,6 +204,42 @@ func _Recon_ResolveCoordinates_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Recon_ResolvePolygon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolvePolygonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReconServer).ResolvePolygon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datacommons.Recon/ResolvePolygon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReconServer).ResolvePolygon(ctx, req.(*ResolvePolygonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Recon_ResolveBoundingBox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveBoundingBoxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReconServer).ResolveBoundingBox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datacommons.Recon/ResolveBoundingBox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReconServer).ResolveBoundingBox(ctx, req.(*ResolveBoundingBoxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Recon_ResolveIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResolveIdsRequest)
 	if err := dec(in); err != nil {
@@ -209,6 +277,14 @@ var Recon_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResolveCoordinates",
 			Handler:    _Recon_ResolveCoordinates_Handler,
 		},
+		{
+			MethodName: "ResolvePolygon",
+			Handler:    _Recon_ResolvePolygon_Handler,
+		},
+		{
+			MethodName: "ResolveBoundingBox",
+			Handler:    _Recon_ResolveBoundingBox_Handler,
+		},
 		{
 			MethodName: "ResolveIds",
 			Handler:    _Recon_ResolveIds_Handler,
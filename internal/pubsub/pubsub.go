@@ -19,6 +19,7 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
@@ -26,10 +27,18 @@ import (
 	"github.com/datacommonsorg/mixer/internal/util"
 )
 
+var (
+	// Subscribers to delete on server shutdown.
+	subscribers     []*pubsub.Subscription
+	subscribersLock sync.Mutex
+	// Makes sure only one signal handler deletes the subscribers.
+	handleShutdown sync.Once
+)
+
 // Subscribe does the following:
 // 1) Create a subscriber within the instance.
 // 2) Start a receiver in a goroutine.
-// 3) Have a goroutine to delete the subscriber on server shutdown.
+// 3) Register the subscriber to delete on server shutdown.
 //
 // TODO(shifucun): Add unittest.
 func Subscribe(
@@ -73,17 +82,32 @@ func Subscribe(
 			log.Printf("Cloud pubsub receive: %v", err)
 		}
 	}()
-	// Create a go routine to check server shutdown and delete the subscriber.
-	c := make(chan os.Signal, 1)
-	signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
-	go func() {
-		<-c
-		err := subscriber.Delete(ctx)
-		if err != nil {
-			log.Fatalf("Failed to delete subscriber: %v", err)
-		}
-		log.Printf("Deleted subscriber: %v", subscriber)
-		os.Exit(1)
-	}()
+	deleteOnShutdown(ctx, subscriber)
 	return nil
 }
+
+// deleteOnShutdown registers a subscriber to delete on server shutdown. The
+// first call starts a goroutine that waits for the shutdown signal, deletes all
+// the registered subscribers and exits.
+func deleteOnShutdown(ctx context.Context, subscriber *pubsub.Subscription) {
+	subscribersLock.Lock()
+	subscribers = append(subscribers, subscriber)
+	subscribersLock.Unlock()
+	handleShutdown.Do(func() {
+		c := make(chan os.Signal, 1)
+		signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
+		go func() {
+			<-c
+			subscribersLock.Lock()
+			defer subscribersLock.Unlock()
+			for _, s := range subscribers {
+				if err := s.Delete(ctx); err != nil {
+					log.Printf("Failed to delete subscriber %s: %v", s.ID(), err)
+					continue
+				}
+				log.Printf("Deleted subscriber: %s", s.ID())
+			}
+			os.Exit(1)
+		}()
+	})
+}
@@ -29,7 +29,7 @@ func GetBioPageDataHelper(
 	dcid string,
 	store *store.Store,
 ) (*pb.GraphNodes, error) {
-	btDataList, err := bigtable.Read(
+	btDataList, _, err := bigtable.Read(
 		ctx,
 		store.BtGroup,
 		bigtable.BtProteinPagePrefix,
@@ -20,10 +20,17 @@ type UnitConversion struct {
 	Scaling float64
 }
 
-// UnitMapping maps unit schemas with scaling factor.
+// UnitMapping maps unit schemas with scaling factor. Bigtable data is unified
+// with it when read. The scaling is taken from Units.
 var UnitMapping = map[string]*UnitConversion{
-	"GigawattHour": {
-		Unit:    "KilowattHour",
-		Scaling: 1000000,
-	},
+	"GigawattHour": unitConversion("GigawattHour", "KilowattHour"),
+}
+
+// unitConversion gets the conversion of a unit to the target unit in Units.
+func unitConversion(unit, targetUnit string) *UnitConversion {
+	scaling, err := UnitFactor(unit, "", targetUnit)
+	if err != nil {
+		panic(err)
+	}
+	return &UnitConversion{Unit: targetUnit, Scaling: scaling}
 }
@@ -0,0 +1,122 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Dimension is the kind of quantity that a unit measures.
+type Dimension string
+
+// Dimensions of the units in Units.
+const (
+	DimensionEnergy Dimension = "Energy"
+	DimensionPower  Dimension = "Power"
+	DimensionMass   Dimension = "Mass"
+	DimensionLength Dimension = "Length"
+	DimensionArea   Dimension = "Area"
+	DimensionVolume Dimension = "Volume"
+)
+
+// Unit represents a unit as a multiple of the base unit of its dimension.
+type Unit struct {
+	Dimension Dimension
+	// Value of one unit in the base unit of the dimension.
+	Factor float64
+}
+
+// Units is the registry of the units that values can be converted between,
+// keyed by unit dcid.
+var Units = map[string]*Unit{
+	// Base unit: Joule
+	"Joule":              {DimensionEnergy, 1},
+	"Kilojoule":          {DimensionEnergy, 1e3},
+	"Megajoule":          {DimensionEnergy, 1e6},
+	"Gigajoule":          {DimensionEnergy, 1e9},
+	"Terajoule":          {DimensionEnergy, 1e12},
+	"Petajoule":          {DimensionEnergy, 1e15},
+	"WattHour":           {DimensionEnergy, 3.6e3},
+	"KilowattHour":       {DimensionEnergy, 3.6e6},
+	"MegawattHour":       {DimensionEnergy, 3.6e9},
+	"GigawattHour":       {DimensionEnergy, 3.6e12},
+	"TerawattHour":       {DimensionEnergy, 3.6e15},
+	"BritishThermalUnit": {DimensionEnergy, 1055.05585262},
+	// Base unit: Watt
+	"Watt":     {DimensionPower, 1},
+	"Kilowatt": {DimensionPower, 1e3},
+	"Megawatt": {DimensionPower, 1e6},
+	"Gigawatt": {DimensionPower, 1e9},
+	"Terawatt": {DimensionPower, 1e12},
+	// Base unit: Kilogram
+	"Gram":      {DimensionMass, 1e-3},
+	"Kilogram":  {DimensionMass, 1},
+	"MetricTon": {DimensionMass, 1e3},
+	"Pound":     {DimensionMass, 0.45359237},
+	// Base unit: Meter
+	"Centimeter": {DimensionLength, 1e-2},
+	"Meter":      {DimensionLength, 1},
+	"Kilometer":  {DimensionLength, 1e3},
+	"Inch":       {DimensionLength, 0.0254},
+	"Foot":       {DimensionLength, 0.3048},
+	"Mile":       {DimensionLength, 1609.344},
+	// Base unit: SquareMeter
+	"SquareMeter":     {DimensionArea, 1},
+	"Hectare":         {DimensionArea, 1e4},
+	"SquareKilometer": {DimensionArea, 1e6},
+	"Acre":            {DimensionArea, 4046.8564224},
+	"SquareMile":      {DimensionArea, 2589988.110336},
+	// Base unit: CubicMeter
+	"Liter":      {DimensionVolume, 1e-3},
+	"CubicMeter": {DimensionVolume, 1},
+}
+
+// UnitFactor gets the factor to convert the values of a unit and a scaling
+// factor to the target unit, without scaling factor.
+//
+// As in StatVarObservation, a value with scaling factor is the actual value
+// multiplied by the scaling factor, like a percentage with scaling factor 100.
+func UnitFactor(unit, scalingFactor, targetUnit string) (float64, error) {
+	factor := 1.0
+	if scalingFactor != "" {
+		scaling, err := strconv.ParseFloat(scalingFactor, 64)
+		if err != nil || scaling == 0 {
+			return 0, status.Errorf(codes.Internal,
+				"invalid scaling factor: %s", scalingFactor)
+		}
+		factor /= scaling
+	}
+	if unit == targetUnit {
+		return factor, nil
+	}
+	target, ok := Units[targetUnit]
+	if !ok {
+		return 0, status.Errorf(codes.InvalidArgument,
+			"unsupported target unit: %s", targetUnit)
+	}
+	source, ok := Units[unit]
+	if !ok || source.Dimension != target.Dimension {
+		return 0, status.Errorf(codes.InvalidArgument,
+			"can not convert unit %q to %s", unit, targetUnit)
+	}
+	// Round to drop the floating point error, so 1 GigawattHour is exactly
+	// 1000000 KilowattHour.
+	factor *= source.Factor / target.Factor
+	factor, _ = strconv.ParseFloat(strconv.FormatFloat(factor, 'g', 12, 64), 64)
+	return factor, nil
+}
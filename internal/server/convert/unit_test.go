@@ -0,0 +1,60 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestUnitFactor(t *testing.T) {
+	for _, c := range []struct {
+		unit          string
+		scalingFactor string
+		targetUnit    string
+		want          float64
+		ok            bool
+	}{
+		{"GigawattHour", "", "KilowattHour", 1e6, true},
+		{"KilowattHour", "", "GigawattHour", 1e-6, true},
+		{"MegawattHour", "1000", "KilowattHour", 1, true},
+		{"Kilojoule", "", "WattHour", 0.277777777778, true},
+		{"Acre", "", "Hectare", 0.40468564224, true},
+		{"Person", "100", "Person", 0.01, true},
+		{"Kilowatt", "", "KilowattHour", 0, false},
+		{"", "", "KilowattHour", 0, false},
+		{"KilowattHour", "", "Parsec", 0, false},
+		{"KilowattHour", "abc", "KilowattHour", 0, false},
+	} {
+		got, err := UnitFactor(c.unit, c.scalingFactor, c.targetUnit)
+		if (err == nil) != c.ok {
+			t.Errorf("UnitFactor(%s, %s, %s) error = %v",
+				c.unit, c.scalingFactor, c.targetUnit, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("UnitFactor(%s, %s, %s) = %v, want %v",
+				c.unit, c.scalingFactor, c.targetUnit, got, c.want)
+		}
+	}
+}
+
+func TestUnitMapping(t *testing.T) {
+	want := &UnitConversion{Unit: "KilowattHour", Scaling: 1000000}
+	if diff := cmp.Diff(UnitMapping["GigawattHour"], want); diff != "" {
+		t.Errorf("UnitMapping[GigawattHour] got diff: %v", diff)
+	}
+}
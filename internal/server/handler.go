@@ -220,21 +220,27 @@ func (s *Server) GetPlaceStatVarsUnionV1(
 func (s *Server) GetStatVarGroup(
 	ctx context.Context, in *pb.GetStatVarGroupRequest,
 ) (*pb.StatVarGroups, error) {
-	return statvar.GetStatVarGroup(ctx, in, s.store, s.cache)
+	cache, done := s.useCache()
+	defer done()
+	return statvar.GetStatVarGroup(ctx, in, s.store, cache)
 }
 
 // GetStatVarGroupNode implements API for Mixer.GetStatVarGroupNode.
 func (s *Server) GetStatVarGroupNode(
 	ctx context.Context, in *pb.GetStatVarGroupNodeRequest,
 ) (*pb.StatVarGroupNode, error) {
-	return statvar.GetStatVarGroupNode(ctx, in, s.store, s.cache)
+	cache, done := s.useCache()
+	defer done()
+	return statvar.GetStatVarGroupNode(ctx, in, s.store, cache)
 }
 
 // GetStatVarPath implements API for Mixer.GetStatVarPath.
 func (s *Server) GetStatVarPath(
 	ctx context.Context, in *pb.GetStatVarPathRequest,
 ) (*pb.GetStatVarPathResponse, error) {
-	return statvarpath.GetStatVarPath(ctx, in, s.store, s.cache)
+	cache, done := s.useCache()
+	defer done()
+	return statvarpath.GetStatVarPath(ctx, in, s.store, cache)
 }
 
 // GetStatVarSummary implements API for Mixer.GetStatVarSummary.
@@ -248,14 +254,18 @@ func (s *Server) GetStatVarSummary(
 func (s *Server) GetStatVarMatch(
 	ctx context.Context, in *pb.GetStatVarMatchRequest,
 ) (*pb.GetStatVarMatchResponse, error) {
-	return statvar.GetStatVarMatch(ctx, in, s.store, s.cache)
+	cache, done := s.useCache()
+	defer done()
+	return statvar.GetStatVarMatch(ctx, in, s.store, cache)
 }
 
 // SearchStatVar implements API for Mixer.SearchStatVar.
 func (s *Server) SearchStatVar(
 	ctx context.Context, in *pb.SearchStatVarRequest,
 ) (*pb.SearchStatVarResponse, error) {
-	return statvar.SearchStatVar(ctx, in, s.store, s.cache)
+	cache, done := s.useCache()
+	defer done()
+	return statvar.SearchStatVar(ctx, in, s.store, cache)
 }
 
 // GetPropertyLabels implements API for Mixer.GetPropertyLabels.
@@ -349,6 +359,13 @@ func (s *Server) GetVersion(
 	}, nil
 }
 
+// GetRankingConfig implements API for Mixer.GetRankingConfig.
+func (s *Server) GetRankingConfig(
+	ctx context.Context, in *pb.GetRankingConfigRequest,
+) (*pb.GetRankingConfigResponse, error) {
+	return rankingConfigResponse(), nil
+}
+
 // ResolveIds implements API for Recon.ResolveIds.
 func (s *Server) ResolveIds(
 	ctx context.Context, in *pb.ResolveIdsRequest,
@@ -360,14 +377,14 @@ func (s *Server) ResolveIds(
 func (s *Server) ResolveEntities(
 	ctx context.Context, in *pb.ResolveEntitiesRequest,
 ) (*pb.ResolveEntitiesResponse, error) {
-	return recon.ResolveEntities(ctx, in, s.store)
+	return recon.ResolveEntities(ctx, in, s.store, s.getNameIndex())
 }
 
 // ResolveCoordinates implements API for ReconServer.ResolveCoordinates.
 func (s *Server) ResolveCoordinates(
 	ctx context.Context, in *pb.ResolveCoordinatesRequest,
 ) (*pb.ResolveCoordinatesResponse, error) {
-	return recon.ResolveCoordinates(ctx, in, s.store)
+	return recon.ResolveCoordinates(ctx, in, s.store, s.polygonCache)
 }
 
 // CompareEntities implements API for Recon.CompareEntities.
@@ -375,13 +392,19 @@ func (s *Server) CompareEntities(
 	ctx context.Context, in *pb.CompareEntitiesRequest,
 ) (
 	*pb.CompareEntitiesResponse, error) {
-	// TODO(spaceenter): Implement.
-	return &pb.CompareEntitiesResponse{
-		Comparisons: []*pb.CompareEntitiesResponse_Comparison{
-			{
-				SourceIds:   []string{"aaa", "bbb"},
-				Probability: 0.67,
-			},
-		},
-	}, nil
+	return recon.CompareEntities(ctx, in, s.store)
+}
+
+// ResolvePolygon implements API for ReconServer.ResolvePolygon.
+func (s *Server) ResolvePolygon(
+	ctx context.Context, in *pb.ResolvePolygonRequest,
+) (*pb.ResolvePolygonResponse, error) {
+	return recon.ResolvePolygon(ctx, in, s.store, s.polygonCache)
+}
+
+// ResolveBoundingBox implements API for ReconServer.ResolveBoundingBox.
+func (s *Server) ResolveBoundingBox(
+	ctx context.Context, in *pb.ResolveBoundingBoxRequest,
+) (*pb.ResolvePolygonResponse, error) {
+	return recon.ResolveBoundingBox(ctx, in, s.store, s.polygonCache)
 }
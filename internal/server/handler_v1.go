@@ -123,7 +123,9 @@ func (s *Server) VariableInfo(
 func (s *Server) VariableGroupInfo(
 	ctx context.Context, in *pb.VariableGroupInfoRequest,
 ) (*pb.StatVarGroupNode, error) {
-	return info.VariableGroupInfo(ctx, in, s.store, s.cache)
+	cache, done := s.useCache()
+	defer done()
+	return info.VariableGroupInfo(ctx, in, s.store, cache)
 }
 
 // BulkVariableInfo implements API for mixer.BulkVariableInfo.
@@ -193,12 +195,16 @@ func (s *Server) PlacePage(
 func (s *Server) VariableAncestors(
 	ctx context.Context, in *pb.VariableAncestorsRequest,
 ) (*pb.VariableAncestorsResponse, error) {
-	return variable.Ancestors(ctx, in, s.store, s.cache)
+	cache, done := s.useCache()
+	defer done()
+	return variable.Ancestors(ctx, in, s.store, cache)
 }
 
 // VariableGroups implements API for Mixer.VariableGroups.
 func (s *Server) VariableGroups(
 	ctx context.Context, in *pb.VariableGroupsRequest,
 ) (*pb.VariableGroupsResponse, error) {
-	return variable.Groups(ctx, in, s.store, s.cache)
+	cache, done := s.useCache()
+	defer done()
+	return variable.Groups(ctx, in, s.store, cache)
 }
@@ -0,0 +1,54 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// healthMethodPrefix is the prefix of the gRPC health check methods.
+const healthMethodPrefix = "/grpc.health.v1.Health/"
+
+// Gate rejects calls to the services of a server until it is opened, while
+// health checks are always served. It lets a server listen and answer probes
+// while its services are still loading.
+type Gate struct {
+	open int32
+}
+
+// Open lets the calls through. Services need to be ready before it is called.
+func (g *Gate) Open() {
+	atomic.StoreInt32(&g.open, 1)
+}
+
+// UnaryInterceptor rejects the calls other than health checks with
+// Unavailable until the gate is open.
+func (g *Gate) UnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if atomic.LoadInt32(&g.open) == 0 && !strings.HasPrefix(info.FullMethod, healthMethodPrefix) {
+		return nil, status.Errorf(codes.Unavailable, "server is loading")
+	}
+	return handler(ctx, req)
+}
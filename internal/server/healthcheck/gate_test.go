@@ -0,0 +1,47 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGate(t *testing.T) {
+	ctx := context.Background()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	call := func(g *Gate, method string) error {
+		_, err := g.UnaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	g := &Gate{}
+	if err := call(g, "/grpc.health.v1.Health/Check"); err != nil {
+		t.Errorf("health check before open = %s, want nil", err)
+	}
+	if err := call(g, "/datacommons.Mixer/Query"); status.Code(err) != codes.Unavailable {
+		t.Errorf("call before open = %v, want Unavailable", err)
+	}
+	g.Open()
+	if err := call(g, "/datacommons.Mixer/Query"); err != nil {
+		t.Errorf("call after open = %s, want nil", err)
+	}
+}
@@ -16,24 +16,200 @@ package healthcheck
 
 import (
 	"context"
+	"log"
+	"sync"
+	"time"
 
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 )
 
-type HealthChecker struct{}
+// Names of the components that the services depend on.
+const (
+	ComponentBigtable = "bigtable"
+	ComponentBigQuery = "bigquery"
+	ComponentMemDb    = "memdb"
+	// Stat var group cache and search indexes.
+	ComponentCache = "cache"
+	// SQLite index of the stat var search.
+	ComponentSQLiteIndex = "sqlite_index"
+	// Branch cache update subscription.
+	ComponentPubsub = "pubsub"
+)
 
-func (s *HealthChecker) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
-	return &grpc_health_v1.HealthCheckResponse{
-		Status: grpc_health_v1.HealthCheckResponse_SERVING,
-	}, nil
-}
+// Names of the services to check health for. The empty service name is the
+// health of the whole server.
+const (
+	ServiceMixer = "datacommons.Mixer"
+	ServiceRecon = "datacommons.Recon"
+)
 
-func (s *HealthChecker) Watch(req *grpc_health_v1.HealthCheckRequest, server grpc_health_v1.Health_WatchServer) error {
-	return server.Send(&grpc_health_v1.HealthCheckResponse{
-		Status: grpc_health_v1.HealthCheckResponse_SERVING,
-	})
+// HealthChecker tracks the readiness of the components of the server. A
+// service is serving when all the components it depends on are ready.
+//
+// Components are not ready when added, until they are set ready.
+type HealthChecker struct {
+	lock sync.Mutex
+	// Component -> whether it is ready.
+	ready map[string]bool
+	// Service -> components it depends on.
+	services map[string][]string
+	// Channels to notify Watch calls about changes.
+	watchers map[chan struct{}]struct{}
 }
 
+// NewHealthChecker creates a health checker without any component, so the
+// whole server is serving.
 func NewHealthChecker() *HealthChecker {
-	return &HealthChecker{}
+	return &HealthChecker{
+		ready:    map[string]bool{},
+		services: map[string][]string{"": {}},
+		watchers: map[chan struct{}]struct{}{},
+	}
+}
+
+// AddService registers a service, so its health can be checked.
+func (h *HealthChecker) AddService(service string) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if _, ok := h.services[service]; !ok {
+		h.services[service] = []string{}
+	}
+	h.notify()
+}
+
+// AddComponent registers a component that the given services and the whole
+// server depend on. The component is not ready until set so.
+func (h *HealthChecker) AddComponent(component string, services ...string) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if _, ok := h.ready[component]; !ok {
+		h.ready[component] = false
+		h.services[""] = append(h.services[""], component)
+	}
+	for _, service := range services {
+		h.services[service] = append(h.services[service], component)
+	}
+	h.notify()
+}
+
+// SetReady sets whether a component is ready.
+func (h *HealthChecker) SetReady(component string, ready bool) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if _, ok := h.ready[component]; !ok {
+		log.Printf("Health check component %s is not registered", component)
+		return
+	}
+	if h.ready[component] != ready {
+		log.Printf("Health check component %s ready: %t", component, ready)
+		h.ready[component] = ready
+		h.notify()
+	}
+}
+
+// Probe runs a probe of a component every interval until the context is
+// done, and sets the component ready when the probe succeeds.
+func (h *HealthChecker) Probe(
+	ctx context.Context,
+	component string,
+	interval time.Duration,
+	probe func(ctx context.Context) error,
+) {
+	run := func() {
+		probeCtx, cancel := context.WithTimeout(ctx, interval)
+		defer cancel()
+		err := probe(probeCtx)
+		if err != nil {
+			log.Printf("Health check probe of %s failed: %v", component, err)
+		}
+		h.SetReady(component, err == nil)
+	}
+	run()
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				run()
+			}
+		}
+	}()
+}
+
+// notify wakes up the Watch calls. Needs to be called with the lock held.
+func (h *HealthChecker) notify() {
+	for ch := range h.watchers {
+		select {
+		case ch <- struct{}{}:
+		default:
+			// A change is already pending for the watcher.
+		}
+	}
+}
+
+// status gets the status of a service, and whether the service is known.
+func (h *HealthChecker) status(
+	service string,
+) (grpc_health_v1.HealthCheckResponse_ServingStatus, bool) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	components, ok := h.services[service]
+	if !ok {
+		return grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN, false
+	}
+	for _, c := range components {
+		if !h.ready[c] {
+			return grpc_health_v1.HealthCheckResponse_NOT_SERVING, true
+		}
+	}
+	return grpc_health_v1.HealthCheckResponse_SERVING, true
+}
+
+// Check implements API for grpc_health_v1.HealthServer.Check.
+func (h *HealthChecker) Check(
+	ctx context.Context, req *grpc_health_v1.HealthCheckRequest,
+) (*grpc_health_v1.HealthCheckResponse, error) {
+	s, ok := h.status(req.GetService())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "Unknown service: %s", req.GetService())
+	}
+	return &grpc_health_v1.HealthCheckResponse{Status: s}, nil
+}
+
+// Watch implements API for grpc_health_v1.HealthServer.Watch. It sends the
+// current status of the service, and then every status change until the
+// client cancels the call.
+func (h *HealthChecker) Watch(
+	req *grpc_health_v1.HealthCheckRequest, server grpc_health_v1.Health_WatchServer,
+) error {
+	ch := make(chan struct{}, 1)
+	h.lock.Lock()
+	h.watchers[ch] = struct{}{}
+	h.lock.Unlock()
+	defer func() {
+		h.lock.Lock()
+		delete(h.watchers, ch)
+		h.lock.Unlock()
+	}()
+
+	var last grpc_health_v1.HealthCheckResponse_ServingStatus = -1
+	for {
+		s, _ := h.status(req.GetService())
+		if s != last {
+			if err := server.Send(&grpc_health_v1.HealthCheckResponse{Status: s}); err != nil {
+				return err
+			}
+			last = s
+		}
+		select {
+		case <-server.Context().Done():
+			return status.Error(codes.Canceled, "Stream has ended")
+		case <-ch:
+		}
+	}
 }
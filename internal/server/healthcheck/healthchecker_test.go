@@ -0,0 +1,154 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	serving    = grpc_health_v1.HealthCheckResponse_SERVING
+	notServing = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+)
+
+func check(t *testing.T, h *HealthChecker, service string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	resp, err := h.Check(context.Background(),
+		&grpc_health_v1.HealthCheckRequest{Service: service})
+	if err != nil {
+		t.Fatalf("Check(%s) = %s", service, err)
+	}
+	return resp.GetStatus()
+}
+
+func TestCheck(t *testing.T) {
+	h := NewHealthChecker()
+	if got := check(t, h, ""); got != serving {
+		t.Errorf("Check() without components = %v, want SERVING", got)
+	}
+
+	h.AddService(ServiceMixer)
+	h.AddService(ServiceRecon)
+	h.AddComponent(ComponentBigtable, ServiceMixer, ServiceRecon)
+	h.AddComponent(ComponentCache, ServiceMixer)
+	for _, service := range []string{"", ServiceMixer, ServiceRecon} {
+		if got := check(t, h, service); got != notServing {
+			t.Errorf("Check(%s) before ready = %v, want NOT_SERVING", service, got)
+		}
+	}
+
+	h.SetReady(ComponentBigtable, true)
+	for service, want := range map[string]grpc_health_v1.HealthCheckResponse_ServingStatus{
+		"":           notServing,
+		ServiceMixer: notServing,
+		ServiceRecon: serving,
+	} {
+		if got := check(t, h, service); got != want {
+			t.Errorf("Check(%s) = %v, want %v", service, got, want)
+		}
+	}
+
+	h.SetReady(ComponentCache, true)
+	if got := check(t, h, ""); got != serving {
+		t.Errorf("Check() with all ready = %v, want SERVING", got)
+	}
+
+	_, err := h.Check(context.Background(),
+		&grpc_health_v1.HealthCheckRequest{Service: "unknown"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Check(unknown) = %v, want NotFound", err)
+	}
+}
+
+func TestProbe(t *testing.T) {
+	h := NewHealthChecker()
+	h.AddComponent(ComponentBigtable)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	h.Probe(ctx, ComponentBigtable, time.Hour, func(ctx context.Context) error {
+		return errors.New("unreachable")
+	})
+	if got := check(t, h, ""); got != notServing {
+		t.Errorf("Check() with failed probe = %v, want NOT_SERVING", got)
+	}
+	h.Probe(ctx, ComponentBigtable, time.Hour, func(ctx context.Context) error {
+		return nil
+	})
+	if got := check(t, h, ""); got != serving {
+		t.Errorf("Check() with passed probe = %v, want SERVING", got)
+	}
+}
+
+type watchServer struct {
+	grpc.ServerStream
+	ctx      context.Context
+	statuses chan grpc_health_v1.HealthCheckResponse_ServingStatus
+}
+
+func (s *watchServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchServer) Send(resp *grpc_health_v1.HealthCheckResponse) error {
+	s.statuses <- resp.GetStatus()
+	return nil
+}
+
+func TestWatch(t *testing.T) {
+	h := NewHealthChecker()
+	h.AddComponent(ComponentCache)
+	ctx, cancel := context.WithCancel(context.Background())
+	server := &watchServer{
+		ctx:      ctx,
+		statuses: make(chan grpc_health_v1.HealthCheckResponse_ServingStatus, 10),
+	}
+	done := make(chan error)
+	go func() {
+		done <- h.Watch(&grpc_health_v1.HealthCheckRequest{}, server)
+	}()
+
+	next := func() grpc_health_v1.HealthCheckResponse_ServingStatus {
+		select {
+		case s := <-server.statuses:
+			return s
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Watch() sent no status")
+			return 0
+		}
+	}
+	if got := next(); got != notServing {
+		t.Errorf("Watch() first status = %v, want NOT_SERVING", got)
+	}
+	h.SetReady(ComponentCache, true)
+	if got := next(); got != serving {
+		t.Errorf("Watch() status after ready = %v, want SERVING", got)
+	}
+	h.SetReady(ComponentCache, false)
+	if got := next(); got != notServing {
+		t.Errorf("Watch() status after not ready = %v, want NOT_SERVING", got)
+	}
+
+	cancel()
+	if err := <-done; status.Code(err) != codes.Canceled {
+		t.Errorf("Watch() = %v after cancel, want Canceled", err)
+	}
+}
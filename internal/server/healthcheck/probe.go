@@ -0,0 +1,44 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"context"
+	"database/sql"
+
+	"cloud.google.com/go/bigquery"
+)
+
+// BigQueryProbe checks that BigQuery accepts queries, with a dry run query
+// that is not billed.
+func BigQueryProbe(client *bigquery.Client) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		q := client.Query("SELECT 1")
+		q.DryRun = true
+		_, err := q.Run(ctx)
+		return err
+	}
+}
+
+// SQLProbe checks that a SQL database can run a query.
+func SQLProbe(db *sql.DB, query string) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		rows, err := db.QueryContext(ctx, query)
+		if err != nil {
+			return err
+		}
+		return rows.Close()
+	}
+}
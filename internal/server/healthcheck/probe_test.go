@@ -0,0 +1,48 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestSQLProbe(t *testing.T) {
+	ctx := context.Background()
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatalf("sql.Open() = %s", err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	probe := SQLProbe(db, "SELECT COUNT(*) FROM statvars")
+	if err := probe(ctx); err == nil {
+		t.Errorf("SQLProbe() without table succeeded, want error")
+	}
+	if _, err := db.Exec("CREATE TABLE statvars (dcid TEXT)"); err != nil {
+		t.Fatalf("CREATE TABLE = %s", err)
+	}
+	if err := probe(ctx); err != nil {
+		t.Errorf("SQLProbe() = %s", err)
+	}
+	db.Close()
+	if err := probe(ctx); err == nil {
+		t.Errorf("SQLProbe() after close succeeded, want error")
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/datacommonsorg/mixer/internal/store/bigtable"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Request and response metadata keys for import group selection.
+const (
+	// Comma separated import groups or tables to only read from.
+	ImportGroupsKey = "import-groups"
+	// Comma separated import groups or tables to not read from.
+	ExcludeImportGroupsKey = "exclude-import-groups"
+	// Comma separated tables that have data for the request, in the response
+	// header.
+	ContributingTablesKey = "contributing-tables"
+)
+
+func metadataList(md metadata.MD, key string) []string {
+	result := []string{}
+	for _, value := range md.Get(key) {
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				result = append(result, v)
+			}
+		}
+	}
+	return result
+}
+
+// ImportGroupInterceptor reads the import group selection of a request from
+// the request metadata, and reports the tables that have data for the request
+// in the response header.
+func ImportGroupInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	filter := &bigtable.ImportGroupFilter{
+		Allow: metadataList(md, ImportGroupsKey),
+		Deny:  metadataList(md, ExcludeImportGroupsKey),
+	}
+	resp, err := handler(bigtable.WithImportGroupFilter(ctx, filter), req)
+	header := metadata.Pairs(
+		ContributingTablesKey, strings.Join(filter.UsedTables(), ","))
+	if headerErr := grpc.SetHeader(ctx, header); headerErr != nil {
+		log.Printf("Failed to set %s header: %v", ContributingTablesKey, headerErr)
+	}
+	return resp, err
+}
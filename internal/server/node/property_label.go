@@ -30,7 +30,7 @@ func GetPropertiesHelper(
 	entities []string,
 	store *store.Store,
 ) (map[string]*pb.PropertyLabels, error) {
-	btDataList, err := bigtable.Read(
+	btDataList, _, err := bigtable.Read(
 		ctx,
 		store.BtGroup,
 		bigtable.BtArcsPrefix,
@@ -67,6 +67,15 @@ func GetPropertiesHelper(
 				}
 			}
 		}
+		// Add the properties of the private import in memdb.
+		if store.MemDb != nil {
+			if labels := store.MemDb.ReadProperties(entity, util.DirectionIn); len(labels) > 0 {
+				inLabelList = append(inLabelList, labels)
+			}
+			if labels := store.MemDb.ReadProperties(entity, util.DirectionOut); len(labels) > 0 {
+				outLabelList = append(outLabelList, labels)
+			}
+		}
 		result[entity].InLabels = util.MergeDedupe(inLabelList...)
 		result[entity].OutLabels = util.MergeDedupe(outLabelList...)
 	}
@@ -68,7 +68,7 @@ func GetPlaceStatDateWithinPlace(
 	for sv, data := range cacheData {
 		if data != nil && len(data.SourceCohorts) > 0 {
 			cohorts := data.SourceCohorts
-			sort.Sort(ranking.CohortByRank(cohorts))
+			ranking.SortCohorts(sv, cohorts)
 			dates := []string{}
 			for date := range cohorts[0].Val {
 				dates = append(dates, date)
@@ -68,7 +68,7 @@ func GetRelatedLocations(
 	}
 	keyBody = append(keyBody, in.GetStatVarDcids())
 	// RelatedPlace cache only exists in base cache
-	btDataList, err := bigtable.Read(
+	btDataList, _, err := bigtable.Read(
 		ctx,
 		store.BtGroup,
 		prefix,
@@ -124,7 +124,7 @@ func GetLocationsRankings(
 		keyBody = append(keyBody, []string{in.GetWithinPlace()})
 	}
 	keyBody = append(keyBody, in.GetStatVarDcids())
-	btDataList, err := bigtable.Read(
+	btDataList, _, err := bigtable.Read(
 		ctx,
 		store.BtGroup,
 		prefix,
@@ -170,7 +170,7 @@ func GetPlaceMetadataHelper(
 	store *store.Store,
 ) (map[string]*pb.PlaceMetadata, error) {
 	// Place metadata are from base geo imports. Only trust the base cache.
-	btDataList, err := bigtable.Read(
+	btDataList, _, err := bigtable.Read(
 		ctx,
 		store.BtGroup,
 		bigtable.BtPlacesMetadataPrefix,
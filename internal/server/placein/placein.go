@@ -42,7 +42,7 @@ func GetPlacesIn(
 	}
 
 	// Place relations are from base geo imports. Only trust the base cache.
-	btDataList, err := bigtable.Read(
+	btDataList, _, err := bigtable.Read(
 		ctx,
 		store.BtGroup,
 		bigtable.BtPlacesInPrefix,
@@ -217,7 +217,7 @@ func fetchBtData(
 	statVars []string,
 ) (map[string]*pb.StatVarSeries, map[string]*pb.PointStat, error) {
 	// Fetch place page cache data in parallel.
-	btDataList, err := bigtable.Read(
+	btDataList, _, err := bigtable.Read(
 		ctx,
 		store.BtGroup,
 		bigtable.BtPlacePagePrefix,
@@ -254,6 +254,7 @@ func fetchBtData(
 					mergedPlacePageData[place].Data[statVar] = obsTimeSeries
 				} else {
 					mergedPlacePageData[place].Data[statVar].SourceSeries = stat.CollectDistinctSourceSeries(
+						statVar,
 						mergedPlacePageData[place].Data[statVar].SourceSeries,
 						obsTimeSeries.SourceSeries,
 					)
@@ -265,10 +266,10 @@ func fetchBtData(
 	for place, data := range mergedPlacePageData {
 		finalData := &pb.StatVarSeries{Data: map[string]*pb.Series{}}
 		for statVar, obsTimeSeries := range data.Data {
-			series, _ := stat.GetBestSeries(obsTimeSeries, "", false /* useLatest */)
+			series, _ := stat.GetBestSeries(obsTimeSeries, statVar, "", false /* useLatest */)
 			finalData.Data[statVar] = series
 			if statVar == "Count_Person" {
-				popSeries, latestDate := stat.GetBestSeries(obsTimeSeries, "", true /* useLatest */)
+				popSeries, latestDate := stat.GetBestSeries(obsTimeSeries, statVar, "", true /* useLatest */)
 				if popSeries != nil {
 					if conversion, ok := convert.UnitMapping[popSeries.Metadata.Unit]; ok {
 						popSeries.Metadata.Unit = conversion.Unit
@@ -0,0 +1,222 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ranking
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+
+	"sigs.k8s.io/yaml"
+)
+
+// DefaultVersion is the version of the built-in ranking.
+const DefaultVersion = "default"
+
+// Config is a versioned ranking config, loaded from a JSON file like:
+//
+//	{
+//	  "version": "2022-10-01",
+//	  "importGroupRank": {"frequent": 1, "infrequent": 10000},
+//	  "statsRanking": [
+//	    {"importName": "CensusPEP", "measurementMethod": "CensusPEPSurvey",
+//	     "observationPeriod": "*", "rank": 0},
+//	    {"statVar": "dc/g/Energy", "importName": "EIA_Electricity",
+//	     "measurementMethod": "*", "observationPeriod": "*", "rank": 0}
+//	  ]
+//	}
+//
+// The same config can be written in YAML, in a file with a .yaml or .yml
+// extension:
+//
+//	version: "2022-10-01"
+//	importGroupRank:
+//	  frequent: 1
+//	  infrequent: 10000
+//	statsRanking:
+//	  - importName: CensusPEP
+//	    measurementMethod: CensusPEPSurvey
+//	    observationPeriod: "*"
+//	    rank: 0
+//	  - statVar: dc/g/Energy
+//	    importName: EIA_Electricity
+//	    measurementMethod: "*"
+//	    observationPeriod: "*"
+//	    rank: 0
+//
+// The built-in ranking is used for the parts that are not set: the import
+// groups not in importGroupRank keep their built-in rank, the ranks without
+// statVar replace StatsRanking, and the ranks with statVar replace
+// StatVarRanking.
+type Config struct {
+	Version string `json:"version"`
+	// Import group -> rank, where lower value ranks higher.
+	ImportGroupRank map[string]int `json:"importGroupRank,omitempty"`
+	StatsRanking    []*StatsRank   `json:"statsRanking,omitempty"`
+}
+
+// StatsRank is the rank of the source series matching a RankKey.
+type StatsRank struct {
+	// Stat var or stat var group the rank applies to, or all stat vars when
+	// empty.
+	StatVar           string `json:"statVar,omitempty"`
+	ImportName        string `json:"importName"`
+	MeasurementMethod string `json:"measurementMethod"`
+	ObservationPeriod string `json:"observationPeriod"`
+	Rank              int    `json:"rank"`
+}
+
+// appliedConfig is the ranking in use.
+type appliedConfig struct {
+	version        string
+	statsRanking   map[RankKey]int
+	statVarRanking map[string]map[RankKey]int
+}
+
+var applied atomic.Value
+
+func init() {
+	applied.Store(&appliedConfig{
+		version:        DefaultVersion,
+		statsRanking:   StatsRanking,
+		statVarRanking: StatVarRanking,
+	})
+}
+
+func currentConfig() *appliedConfig {
+	return applied.Load().(*appliedConfig)
+}
+
+// ParseConfig parses and validates a ranking config in JSON.
+func ParseConfig(data []byte) (*Config, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	c := &Config{}
+	if err := decoder.Decode(c); err != nil {
+		return nil, err
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ParseConfigFile parses and validates a ranking config read from a file, in
+// YAML when the file has a .yaml or .yml extension and in JSON otherwise.
+func ParseConfigFile(path string, data []byte) (*Config, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		// Convert to JSON, so the same field names and checks apply.
+		jsonData, err := yaml.YAMLToJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		data = jsonData
+	}
+	return ParseConfig(data)
+}
+
+// Validate checks that a config is complete and has no conflicting ranks.
+func (c *Config) Validate() error {
+	if c.Version == "" {
+		return fmt.Errorf("version is required")
+	}
+	for group, rank := range c.ImportGroupRank {
+		if group == "" || strings.Contains(group, "_") {
+			return fmt.Errorf("invalid import group %q, want a name without \"_\"", group)
+		}
+		if rank < 0 {
+			return fmt.Errorf("negative rank %d of import group %s", rank, group)
+		}
+	}
+	type scopedKey struct {
+		statVar string
+		key     RankKey
+	}
+	seen := map[scopedKey]struct{}{}
+	for _, r := range c.StatsRanking {
+		if r.ImportName == "" {
+			return fmt.Errorf("importName is required in statsRanking")
+		}
+		if r.Rank < 0 {
+			return fmt.Errorf("negative rank %d of import %s", r.Rank, r.ImportName)
+		}
+		key := scopedKey{r.StatVar, RankKey{r.ImportName, r.MeasurementMethod, r.ObservationPeriod}}
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate statsRanking entry %+v", *r)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+// ApplyConfig makes the source ranking of a config the ranking in use. The
+// import group ranking needs to be applied to the Bigtable groups separately.
+func ApplyConfig(c *Config) {
+	statsRanking := map[RankKey]int{}
+	statVarRanking := map[string]map[RankKey]int{}
+	for _, r := range c.StatsRanking {
+		key := RankKey{r.ImportName, r.MeasurementMethod, r.ObservationPeriod}
+		if r.StatVar == "" {
+			statsRanking[key] = r.Rank
+			continue
+		}
+		if _, ok := statVarRanking[r.StatVar]; !ok {
+			statVarRanking[r.StatVar] = map[RankKey]int{}
+		}
+		statVarRanking[r.StatVar][key] = r.Rank
+	}
+	if len(statsRanking) == 0 {
+		statsRanking = StatsRanking
+	}
+	if len(statVarRanking) == 0 {
+		statVarRanking = StatVarRanking
+	}
+	applied.Store(&appliedConfig{
+		version:        c.Version,
+		statsRanking:   statsRanking,
+		statVarRanking: statVarRanking,
+	})
+}
+
+// CurrentVersion gets the version of the ranking in use.
+func CurrentVersion() string {
+	return currentConfig().version
+}
+
+// CurrentStatsRanking gets a copy of the source ranking in use.
+func CurrentStatsRanking() map[RankKey]int {
+	result := map[RankKey]int{}
+	for k, v := range currentConfig().statsRanking {
+		result[k] = v
+	}
+	return result
+}
+
+// CurrentStatVarRanking gets a copy of the scoped source ranking in use, keyed
+// by stat var or stat var group.
+func CurrentStatVarRanking() map[string]map[RankKey]int {
+	result := map[string]map[RankKey]int{}
+	for statVar, rules := range currentConfig().statVarRanking {
+		result[statVar] = map[RankKey]int{}
+		for k, v := range rules {
+			result[statVar][k] = v
+		}
+	}
+	return result
+}
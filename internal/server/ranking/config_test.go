@@ -0,0 +1,128 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ranking
+
+import (
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseConfigInvalid(t *testing.T) {
+	for _, config := range []string{
+		`{}`,
+		`{"version": "1", "unknown": 1}`,
+		`{"version": "1", "importGroupRank": {"frequent_2022": 1}}`,
+		`{"version": "1", "importGroupRank": {"frequent": -1}}`,
+		`{"version": "1", "statsRanking": [{"measurementMethod": "*", "rank": 1}]}`,
+		`{"version": "1", "statsRanking": [
+			{"importName": "CensusPEP", "measurementMethod": "*", "observationPeriod": "*", "rank": 0},
+			{"importName": "CensusPEP", "measurementMethod": "*", "observationPeriod": "*", "rank": 1}
+		]}`,
+		`{"version": "1", "statsRanking": [
+			{"statVar": "dc/g/Energy", "importName": "UNEnergy", "measurementMethod": "*", "observationPeriod": "*", "rank": 0},
+			{"statVar": "dc/g/Energy", "importName": "UNEnergy", "measurementMethod": "*", "observationPeriod": "*", "rank": 1}
+		]}`,
+	} {
+		if _, err := ParseConfig([]byte(config)); err == nil {
+			t.Errorf("ParseConfig(%s) got no error", config)
+		}
+	}
+}
+
+func TestParseConfigFile(t *testing.T) {
+	want, err := ParseConfig([]byte(`{
+		"version": "2022-10-01",
+		"importGroupRank": {"frequent": 1},
+		"statsRanking": [
+			{"statVar": "dc/g/Energy", "importName": "EIA_Electricity",
+			 "measurementMethod": "*", "observationPeriod": "*", "rank": 0}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("ParseConfig() = %s", err)
+	}
+	yamlConfig := []byte(`
+version: "2022-10-01"
+importGroupRank:
+  frequent: 1
+statsRanking:
+  - statVar: dc/g/Energy
+    importName: EIA_Electricity
+    measurementMethod: "*"
+    observationPeriod: "*"
+    rank: 0
+`)
+	for _, path := range []string{"ranking.yaml", "ranking.YML"} {
+		got, err := ParseConfigFile(path, yamlConfig)
+		if err != nil {
+			t.Fatalf("ParseConfigFile(%s) = %s", path, err)
+		}
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("ParseConfigFile(%s) got diff: %v", path, diff)
+		}
+	}
+	// Unknown fields are rejected in YAML too.
+	if _, err := ParseConfigFile("ranking.yaml", []byte("version: \"1\"\nunknown: 1\n")); err == nil {
+		t.Errorf("ParseConfigFile() with unknown field got no error")
+	}
+	// Other files are JSON.
+	if _, err := ParseConfigFile("ranking.json", yamlConfig); err == nil {
+		t.Errorf("ParseConfigFile() of YAML in a .json file got no error")
+	}
+}
+
+func TestApplyConfig(t *testing.T) {
+	defer ApplyConfig(&Config{Version: DefaultVersion})
+
+	census := &pb.SourceSeries{ImportName: "CensusPEP", MeasurementMethod: "CensusPEPSurvey"}
+	wdi := &pb.SourceSeries{ImportName: "WorldDevelopmentIndicators"}
+	c, err := ParseConfig([]byte(`{
+		"version": "2022-10-01",
+		"statsRanking": [
+			{"importName": "WorldDevelopmentIndicators", "measurementMethod": "*",
+			 "observationPeriod": "*", "rank": 0},
+			{"importName": "CensusPEP", "measurementMethod": "*",
+			 "observationPeriod": "*", "rank": 1}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("ParseConfig() = %s", err)
+	}
+	ApplyConfig(c)
+	if got := CurrentVersion(); got != "2022-10-01" {
+		t.Errorf("CurrentVersion() = %s, want 2022-10-01", got)
+	}
+	for _, want := range []struct {
+		series *pb.SourceSeries
+		score  int
+	}{
+		{wdi, 0},
+		{census, 1},
+		// Not in the config.
+		{&pb.SourceSeries{ImportName: "BLS_LAUS", MeasurementMethod: "BLSSeasonallyUnadjusted"}, BaseRank},
+	} {
+		if got := GetScorePb(want.series); got != want.score {
+			t.Errorf("GetScorePb(%s) = %d, want %d", want.series.ImportName, got, want.score)
+		}
+	}
+
+	// Configs without statsRanking use the default ranking.
+	ApplyConfig(&Config{Version: "2022-10-02"})
+	if got := GetScorePb(census); got != 0 {
+		t.Errorf("GetScorePb(CensusPEP) = %d with default ranking, want 0", got)
+	}
+}
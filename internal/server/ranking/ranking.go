@@ -35,6 +35,9 @@ type RankKey struct {
 
 // StatsRanking is used to rank multiple source series for the same
 // StatisticalVariable, where lower value means higher ranking.
+//
+// This is the default ranking. The ranking in use can be changed with
+// ApplyConfig.
 var StatsRanking = map[RankKey]int{
 	{"CensusPEP", "CensusPEPSurvey", "*"}:                                0, // Population
 	{"CensusACS5YearSurvey", "CensusACS5yrSurvey", "*"}:                  1, // Population
@@ -106,52 +109,24 @@ type CohortByRank []*pb.SourceSeries
 //
 // If no entry is found, a BaseRank is assigned to the source series.
 func GetScorePb(s *pb.SourceSeries) int {
-	for _, propCombination := range []struct {
-		mm string
-		op string
-	}{
-		// Check exact match first
-		{s.MeasurementMethod, s.ObservationPeriod},
-		{s.MeasurementMethod, "*"},
-		{"*", s.ObservationPeriod},
-		{"*", "*"},
-	} {
-		key := RankKey{
-			ImportName:        s.ImportName,
-			MeasurementMethod: propCombination.mm,
-			ObservationPeriod: propCombination.op,
-		}
-		score, ok := StatsRanking[key]
-		if ok {
-			return score
-		}
-	}
-	return BaseRank
+	return score(globalRules(), s.ImportName, s.MeasurementMethod, s.ObservationPeriod)
+}
+
+// GetStatVarScorePb derives the ranking score for a source series of a stat
+// var, with the rules of the stat var and its stat var groups before the
+// global StatsRanking.
+func GetStatVarScorePb(statVar string, s *pb.SourceSeries) int {
+	return score(statVarRules(statVar), s.ImportName, s.MeasurementMethod, s.ObservationPeriod)
 }
 
 // GetMetadataScore computes score for pb.StatMetadata
 func GetMetadataScore(m *pb.StatMetadata) int {
-	for _, propCombination := range []struct {
-		mm string
-		op string
-	}{
-		// Check exact match first
-		{m.MeasurementMethod, m.ObservationPeriod},
-		{m.MeasurementMethod, "*"},
-		{"*", m.ObservationPeriod},
-		{"*", "*"},
-	} {
-		key := RankKey{
-			ImportName:        m.ImportName,
-			MeasurementMethod: propCombination.mm,
-			ObservationPeriod: propCombination.op,
-		}
-		score, ok := StatsRanking[key]
-		if ok {
-			return score
-		}
-	}
-	return BaseRank
+	return score(globalRules(), m.ImportName, m.MeasurementMethod, m.ObservationPeriod)
+}
+
+// GetStatVarMetadataScore is GetStatVarScorePb for pb.StatMetadata.
+func GetStatVarMetadataScore(statVar string, m *pb.StatMetadata) int {
+	return score(statVarRules(statVar), m.ImportName, m.MeasurementMethod, m.ObservationPeriod)
 }
 
 func (a CohortByRank) Len() int {
@@ -163,10 +138,10 @@ func (a CohortByRank) Swap(i, j int) {
 }
 
 func (a CohortByRank) Less(i, j int) bool {
-	oi := a[i]
-	scorei := GetScorePb(oi)
-	oj := a[j]
-	scorej := GetScorePb(oj)
+	return cohortLess(a[i], a[j], GetScorePb(a[i]), GetScorePb(a[j]))
+}
+
+func cohortLess(oi, oj *pb.SourceSeries, scorei, scorej int) bool {
 	// Higher score value means lower rank.
 	if scorei != scorej {
 		return scorei < scorej
@@ -202,25 +177,24 @@ func (a SeriesByRank) Len() int { return len(a) }
 func (a SeriesByRank) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
 
 func (a SeriesByRank) Less(i, j int) bool {
-	oi := a[i]
-	scorei := GetScorePb(oi)
-	oj := a[j]
-	scorej := GetScorePb(oj)
+	return seriesLess(a[i], a[j], GetScorePb(a[i]), GetScorePb(a[j]))
+}
 
+func seriesLess(oi, oj *pb.SourceSeries, scorei, scorej int) bool {
 	// Higher score value means lower rank.
 	if scorei != scorej {
 		return scorei < scorej
 	}
 
 	latesti := ""
-	for date := range a[i].Val {
+	for date := range oi.Val {
 		if date > latesti {
 			latesti = date
 		}
 	}
 
 	latestj := ""
-	for date := range a[j].Val {
+	for date := range oj.Val {
 		if date > latestj {
 			latestj = date
 		}
@@ -232,8 +206,8 @@ func (a SeriesByRank) Less(i, j int) bool {
 	}
 
 	// Series with more data is ranked higher
-	if len(a[i].Val) != len(a[j].Val) {
-		return len(a[i].Val) > len(a[j].Val)
+	if len(oi.Val) != len(oj.Val) {
+		return len(oi.Val) > len(oj.Val)
 	}
 
 	// Compare other fields to get consistent ranking.
@@ -272,27 +246,12 @@ func (a SeriesByRank) Less(i, j int) bool {
 //
 // If no entry is found, a BaseRank is assigned to the source series.
 func GetScore(s *model.SourceSeries) int {
-	for _, propCombination := range []struct {
-		mm string
-		op string
-	}{
-		// Check exact match first
-		{s.MeasurementMethod, s.ObservationPeriod},
-		{s.MeasurementMethod, "*"},
-		{"*", s.ObservationPeriod},
-		{"*", "*"},
-	} {
-		key := RankKey{
-			ImportName:        s.ImportName,
-			MeasurementMethod: propCombination.mm,
-			ObservationPeriod: propCombination.op,
-		}
-		score, ok := StatsRanking[key]
-		if ok {
-			return score
-		}
-	}
-	return BaseRank
+	return score(globalRules(), s.ImportName, s.MeasurementMethod, s.ObservationPeriod)
+}
+
+// GetStatVarScore is GetStatVarScorePb for model.SourceSeries.
+func GetStatVarScore(statVar string, s *model.SourceSeries) int {
+	return score(statVarRules(statVar), s.ImportName, s.MeasurementMethod, s.ObservationPeriod)
 }
 
 // ByRank implements sort.Interface for []*SourceSeries based on
@@ -304,24 +263,24 @@ func (a ByRank) Len() int { return len(a) }
 func (a ByRank) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
 
 func (a ByRank) Less(i, j int) bool {
-	oi := a[i]
-	scorei := GetScore(oi)
-	oj := a[j]
-	scorej := GetScore(oj)
+	return byRankLess(a[i], a[j], GetScore(a[i]), GetScore(a[j]))
+}
+
+func byRankLess(oi, oj *model.SourceSeries, scorei, scorej int) bool {
 	// Higher score value means lower rank.
 	if scorei != scorej {
 		return scorei < scorej
 	}
 
 	latesti := ""
-	for date := range a[i].Val {
+	for date := range oi.Val {
 		if date > latesti {
 			latesti = date
 		}
 	}
 
 	latestj := ""
-	for date := range a[j].Val {
+	for date := range oj.Val {
 		if date > latestj {
 			latestj = date
 		}
@@ -333,8 +292,8 @@ func (a ByRank) Less(i, j int) bool {
 	}
 
 	// Series with more data is ranked higher
-	if len(a[i].Val) != len(a[j].Val) {
-		return len(a[i].Val) > len(a[j].Val)
+	if len(oi.Val) != len(oj.Val) {
+		return len(oi.Val) > len(oj.Val)
 	}
 
 	// Compare other fields to get consistent ranking.
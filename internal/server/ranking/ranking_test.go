@@ -104,3 +104,90 @@ func TestSeriesByRank(t *testing.T) {
 		}
 	}
 }
+
+func TestSortSeriesStatVar(t *testing.T) {
+	defer ApplyConfig(&Config{Version: DefaultVersion})
+	defer SetParentSvg(nil)
+
+	SetParentSvg(map[string][]string{
+		"Count_Person":               {"dc/g/Demographics"},
+		"Annual_Generation_Electric": {"dc/g/Energy_Electricity"},
+		"dc/g/Energy_Electricity":    {"dc/g/Energy"},
+		"dc/g/Energy":                {"dc/g/Root"},
+	})
+	c, err := ParseConfig([]byte(`{
+		"version": "2022-10-01",
+		"statsRanking": [
+			{"importName": "UNEnergy", "measurementMethod": "*",
+			 "observationPeriod": "*", "rank": 0},
+			{"importName": "EIA_Electricity", "measurementMethod": "*",
+			 "observationPeriod": "*", "rank": 1},
+			{"statVar": "dc/g/Energy", "importName": "EIA_Electricity",
+			 "measurementMethod": "*", "observationPeriod": "*", "rank": 0},
+			{"statVar": "dc/g/Energy", "importName": "UNEnergy",
+			 "measurementMethod": "*", "observationPeriod": "*", "rank": 1},
+			{"statVar": "Annual_Generation_Electric", "importName": "UNEnergy",
+			 "measurementMethod": "Exact", "observationPeriod": "*", "rank": 0}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("ParseConfig() = %s", err)
+	}
+	ApplyConfig(c)
+
+	for _, c := range []struct {
+		statVar  string
+		series   []*pb.SourceSeries
+		expected []*pb.SourceSeries
+	}{
+		{
+			// Global rules.
+			"Count_Person",
+			[]*pb.SourceSeries{
+				{ImportName: "EIA_Electricity"},
+				{ImportName: "UNEnergy"},
+			},
+			[]*pb.SourceSeries{
+				{ImportName: "UNEnergy"},
+				{ImportName: "EIA_Electricity"},
+			},
+		},
+		{
+			// Rules of the ancestor stat var group.
+			"Annual_Generation_Electric",
+			[]*pb.SourceSeries{
+				{ImportName: "UNEnergy"},
+				{ImportName: "BLS_LAUS"},
+				{ImportName: "EIA_Electricity"},
+			},
+			[]*pb.SourceSeries{
+				{ImportName: "EIA_Electricity"},
+				{ImportName: "UNEnergy"},
+				{ImportName: "BLS_LAUS"},
+			},
+		},
+		{
+			// Rules of the stat var come first, and ties are broken as
+			// SeriesByRank.
+			"Annual_Generation_Electric",
+			[]*pb.SourceSeries{
+				{ImportName: "EIA_Electricity"},
+				{ImportName: "UNEnergy", MeasurementMethod: "Exact"},
+			},
+			[]*pb.SourceSeries{
+				{ImportName: "EIA_Electricity"},
+				{ImportName: "UNEnergy", MeasurementMethod: "Exact"},
+			},
+		},
+	} {
+		SortSeries(c.statVar, c.series)
+		if diff := cmp.Diff(c.expected, c.series, protocmp.Transform()); diff != "" {
+			t.Errorf("SortSeries(%s) got diff result %v", c.statVar, diff)
+		}
+		cohorts := append([]*pb.SourceSeries{}, c.series...)
+		SortCohorts(c.statVar, cohorts)
+		if got, want := cohorts[0].ImportName, c.expected[0].ImportName; got != want {
+			t.Errorf("SortCohorts(%s) first import = %s, want %s", c.statVar, got, want)
+		}
+	}
+}
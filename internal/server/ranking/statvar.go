@@ -0,0 +1,196 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ranking
+
+import (
+	"sort"
+	"sync/atomic"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/model"
+)
+
+// StatVarRanking holds the source ranking rules scoped to a stat var or a stat
+// var group, keyed by the stat var or stat var group dcid. A rule of a stat
+// var group applies to all the stat vars under the group.
+//
+// For a source series of a stat var, the rules of the stat var are checked
+// first, then the rules of its stat var groups from the nearest one, then
+// StatsRanking.
+//
+// This is the default scoped ranking. The ranking in use can be changed with
+// ApplyConfig.
+var StatVarRanking = map[string]map[RankKey]int{}
+
+// parentSvg is the map of stat var or stat var group dcid to its parent stat
+// var groups.
+var parentSvg atomic.Value
+
+func init() {
+	parentSvg.Store(map[string][]string{})
+}
+
+// SetParentSvg sets the stat var group hierarchy used to find the rules of the
+// stat var groups of a stat var. It takes the map of stat var or stat var group
+// dcid to its parent stat var groups, as in resource.Cache.ParentSvg.
+func SetParentSvg(parents map[string][]string) {
+	if parents == nil {
+		parents = map[string][]string{}
+	}
+	parentSvg.Store(parents)
+}
+
+// ancestorSvgs gets the stat var groups of a stat var, the nearest first.
+func ancestorSvgs(statVar string) []string {
+	parents := parentSvg.Load().(map[string][]string)
+	result := []string{}
+	seen := map[string]struct{}{statVar: {}}
+	queue := []string{statVar}
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+		for _, svg := range parents[curr] {
+			if _, ok := seen[svg]; ok {
+				continue
+			}
+			seen[svg] = struct{}{}
+			result = append(result, svg)
+			queue = append(queue, svg)
+		}
+	}
+	return result
+}
+
+// globalRules gets the rules in use for sources of any stat var.
+func globalRules() []map[RankKey]int {
+	return []map[RankKey]int{currentConfig().statsRanking}
+}
+
+// statVarRules gets the rules in use for the sources of a stat var, in the
+// order to check.
+func statVarRules(statVar string) []map[RankKey]int {
+	c := currentConfig()
+	if statVar == "" || len(c.statVarRanking) == 0 {
+		return []map[RankKey]int{c.statsRanking}
+	}
+	result := []map[RankKey]int{}
+	if rules, ok := c.statVarRanking[statVar]; ok {
+		result = append(result, rules)
+	}
+	for _, svg := range ancestorSvgs(statVar) {
+		if rules, ok := c.statVarRanking[svg]; ok {
+			result = append(result, rules)
+		}
+	}
+	return append(result, c.statsRanking)
+}
+
+// score gets the score of the first rule that matches the source, or BaseRank.
+func score(rules []map[RankKey]int, importName, mm, op string) int {
+	for _, statsRanking := range rules {
+		for _, propCombination := range []struct {
+			mm string
+			op string
+		}{
+			// Check exact match first
+			{mm, op},
+			{mm, "*"},
+			{"*", op},
+			{"*", "*"},
+		} {
+			key := RankKey{
+				ImportName:        importName,
+				MeasurementMethod: propCombination.mm,
+				ObservationPeriod: propCombination.op,
+			}
+			if score, ok := statsRanking[key]; ok {
+				return score
+			}
+		}
+	}
+	return BaseRank
+}
+
+type statVarCohortByRank struct {
+	rules   []map[RankKey]int
+	cohorts []*pb.SourceSeries
+}
+
+func (a statVarCohortByRank) Len() int { return len(a.cohorts) }
+
+func (a statVarCohortByRank) Swap(i, j int) {
+	a.cohorts[i], a.cohorts[j] = a.cohorts[j], a.cohorts[i]
+}
+
+func (a statVarCohortByRank) Less(i, j int) bool {
+	oi, oj := a.cohorts[i], a.cohorts[j]
+	return cohortLess(oi, oj,
+		score(a.rules, oi.ImportName, oi.MeasurementMethod, oi.ObservationPeriod),
+		score(a.rules, oj.ImportName, oj.MeasurementMethod, oj.ObservationPeriod))
+}
+
+type statVarSeriesByRank struct {
+	rules  []map[RankKey]int
+	series []*pb.SourceSeries
+}
+
+func (a statVarSeriesByRank) Len() int { return len(a.series) }
+
+func (a statVarSeriesByRank) Swap(i, j int) {
+	a.series[i], a.series[j] = a.series[j], a.series[i]
+}
+
+func (a statVarSeriesByRank) Less(i, j int) bool {
+	oi, oj := a.series[i], a.series[j]
+	return seriesLess(oi, oj,
+		score(a.rules, oi.ImportName, oi.MeasurementMethod, oi.ObservationPeriod),
+		score(a.rules, oj.ImportName, oj.MeasurementMethod, oj.ObservationPeriod))
+}
+
+type statVarByRank struct {
+	rules  []map[RankKey]int
+	series []*model.SourceSeries
+}
+
+func (a statVarByRank) Len() int { return len(a.series) }
+
+func (a statVarByRank) Swap(i, j int) {
+	a.series[i], a.series[j] = a.series[j], a.series[i]
+}
+
+func (a statVarByRank) Less(i, j int) bool {
+	oi, oj := a.series[i], a.series[j]
+	return byRankLess(oi, oj,
+		score(a.rules, oi.ImportName, oi.MeasurementMethod, oi.ObservationPeriod),
+		score(a.rules, oj.ImportName, oj.MeasurementMethod, oj.ObservationPeriod))
+}
+
+// SortCohorts sorts the cohorts of a stat var as CohortByRank, with the rules
+// of the stat var.
+func SortCohorts(statVar string, cohorts []*pb.SourceSeries) {
+	sort.Sort(statVarCohortByRank{rules: statVarRules(statVar), cohorts: cohorts})
+}
+
+// SortSeries sorts the source series of a stat var as SeriesByRank, with the
+// rules of the stat var.
+func SortSeries(statVar string, series []*pb.SourceSeries) {
+	sort.Sort(statVarSeriesByRank{rules: statVarRules(statVar), series: series})
+}
+
+// SortByRank sorts the source series of a stat var as ByRank, with the rules
+// of the stat var.
+func SortByRank(statVar string, series []*model.SourceSeries) {
+	sort.Sort(statVarByRank{rules: statVarRules(statVar), series: series})
+}
@@ -0,0 +1,136 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"sort"
+	"time"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/ranking"
+	"github.com/datacommonsorg/mixer/internal/store/bigtable"
+)
+
+// applyRankingConfig makes a ranking config the ranking in use, and sorts the
+// tables of the groups by the new import group rank.
+func applyRankingConfig(c *ranking.Config, groups []*bigtable.Group) {
+	bigtable.SetGroupRank(c.ImportGroupRank)
+	for _, g := range groups {
+		g.Sort()
+	}
+	// The version changes last, once the whole config is in use.
+	ranking.ApplyConfig(c)
+	log.Printf("Applied ranking config version %s", c.Version)
+}
+
+// WatchRankingConfig loads the ranking config file and applies it to the
+// groups, then reloads the file every interval until the context is done. An
+// invalid config at startup is an error; later invalid configs are logged and
+// the ranking in use is kept.
+func WatchRankingConfig(
+	ctx context.Context,
+	path string,
+	interval time.Duration,
+	groups ...*bigtable.Group,
+) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	c, err := ranking.ParseConfigFile(path, data)
+	if err != nil {
+		return fmt.Errorf("invalid ranking config %s: %v", path, err)
+	}
+	applyRankingConfig(c, groups)
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			newData, err := ioutil.ReadFile(path)
+			if err != nil {
+				log.Printf("Failed to read ranking config: %v", err)
+				continue
+			}
+			if bytes.Equal(newData, data) {
+				continue
+			}
+			data = newData
+			c, err := ranking.ParseConfigFile(path, data)
+			if err != nil {
+				log.Printf("Invalid ranking config, keeping the current one: %v", err)
+				continue
+			}
+			applyRankingConfig(c, groups)
+		}
+	}()
+	return nil
+}
+
+// rankingConfigResponse gets the ranking in use.
+func rankingConfigResponse() *pb.GetRankingConfigResponse {
+	res := &pb.GetRankingConfigResponse{
+		Version:          ranking.CurrentVersion(),
+		ImportGroupRanks: map[string]int32{},
+	}
+	for group, rank := range bigtable.GroupRank() {
+		res.ImportGroupRanks[group] = int32(rank)
+	}
+	for key, rank := range ranking.CurrentStatsRanking() {
+		res.StatsRanking = append(res.StatsRanking, &pb.GetRankingConfigResponse_StatsRank{
+			ImportName:        key.ImportName,
+			MeasurementMethod: key.MeasurementMethod,
+			ObservationPeriod: key.ObservationPeriod,
+			Rank:              int32(rank),
+		})
+	}
+	for statVar, rules := range ranking.CurrentStatVarRanking() {
+		for key, rank := range rules {
+			res.StatsRanking = append(res.StatsRanking, &pb.GetRankingConfigResponse_StatsRank{
+				ImportName:        key.ImportName,
+				MeasurementMethod: key.MeasurementMethod,
+				ObservationPeriod: key.ObservationPeriod,
+				Rank:              int32(rank),
+				StatVar:           statVar,
+			})
+		}
+	}
+	sort.Slice(res.StatsRanking, func(i, j int) bool {
+		ri, rj := res.StatsRanking[i], res.StatsRanking[j]
+		if ri.StatVar != rj.StatVar {
+			return ri.StatVar < rj.StatVar
+		}
+		if ri.Rank != rj.Rank {
+			return ri.Rank < rj.Rank
+		}
+		if ri.ImportName != rj.ImportName {
+			return ri.ImportName < rj.ImportName
+		}
+		if ri.MeasurementMethod != rj.MeasurementMethod {
+			return ri.MeasurementMethod < rj.MeasurementMethod
+		}
+		return ri.ObservationPeriod < rj.ObservationPeriod
+	})
+	return res
+}
@@ -0,0 +1,312 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recon
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The evidence of two entities being the same is added up as log odds, starting
+// from even odds, and mapped to a match score in [0, 1] at the end.
+//
+// The weights are hand-set heuristics, picked so that a shared ID outweighs
+// names and coordinates, and they are not fitted on labeled entity pairs. So
+// the score, reported as the comparison probability, ranks and thresholds the
+// comparisons, and is not the frequency of matches among pairs with that score.
+const (
+	// Both entities have the same value of an ID property.
+	idMatchLogOdds = 8.0
+	// Both entities have an ID property but with different values.
+	idConflictLogOdds = -8.0
+	// The IDs of both entities resolve to a common DC entity.
+	resolvedMatchLogOdds = 6.0
+	// The IDs of both entities resolve to different DC entities.
+	resolvedConflictLogOdds = -6.0
+	// Maximum log odds from names, for identical names. Names with similarity
+	// under 0.5 count against the match.
+	nameMaxLogOdds = 3.0
+	// Maximum log odds from coordinates, for the same location.
+	coordinateMaxLogOdds = 2.0
+	// Minimum log odds from coordinates, for far away locations.
+	coordinateMinLogOdds = -4.0
+	// Distance in km at which coordinates stop being evidence for a match.
+	coordinateNeutralKm = 20.0
+	earthRadiusKm       = 6371.0
+)
+
+// entityInfo holds the properties of an entity used for comparison.
+type entityInfo struct {
+	sourceID string
+	// ID prop -> ID val.
+	ids   map[string]string
+	names []string
+	// Whether latitude and longitude are set.
+	hasCoordinate bool
+	lat           float64
+	lng           float64
+	// DCIDs that the IDs resolve to.
+	resolved map[string]struct{}
+}
+
+// getEntityInfo reads the IDs, names and coordinates of an entity.
+func getEntityInfo(entity *pb.EntitySubGraph) (*entityInfo, error) {
+	sourceID := entity.GetSourceId()
+	info := &entityInfo{
+		sourceID: sourceID,
+		ids:      map[string]string{},
+		resolved: map[string]struct{}{},
+	}
+	switch t := entity.GraphRepresentation.(type) {
+	case *pb.EntitySubGraph_SubGraph:
+		node, ok := (entity.GetSubGraph().GetNodes())[sourceID]
+		if !ok {
+			return nil, status.Errorf(
+				codes.InvalidArgument, "source_id %s is not in sub_graph", sourceID)
+		}
+		for _, idProp := range rankedIDProps {
+			if idVal := getPropVal(node, idProp); idVal != "" {
+				info.ids[idProp] = idVal
+			}
+		}
+		for _, prop := range []string{"name", "alternateName"} {
+			for _, v := range node.GetPvs()[prop].GetTypedValues() {
+				if v.GetValue() != "" {
+					info.names = append(info.names, v.GetValue())
+				}
+			}
+		}
+		lat, err1 := strconv.ParseFloat(getPropVal(node, "latitude"), 64)
+		lng, err2 := strconv.ParseFloat(getPropVal(node, "longitude"), 64)
+		if err1 == nil && err2 == nil {
+			info.hasCoordinate = true
+			info.lat = lat
+			info.lng = lng
+		}
+	case *pb.EntitySubGraph_EntityIds:
+		for _, id := range entity.GetEntityIds().GetIds() {
+			info.ids[id.GetProp()] = id.GetVal()
+		}
+	default:
+		return nil, fmt.Errorf("Entity.GraphRepresentation has unexpected type %T", t)
+	}
+	return info, nil
+}
+
+// CompareEntities implements API for ReconServer.CompareEntities.
+func CompareEntities(
+	ctx context.Context, in *pb.CompareEntitiesRequest, store *store.Store,
+) (
+	*pb.CompareEntitiesResponse, error) {
+	pairs := [][2]*entityInfo{}
+	idKeySet := map[string]struct{}{}
+	idKeys := []string{}
+	for _, pair := range in.GetEntityPairs() {
+		one, err := getEntityInfo(pair.GetEntityOne())
+		if err != nil {
+			return nil, err
+		}
+		two, err := getEntityInfo(pair.GetEntityTwo())
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range []*entityInfo{one, two} {
+			for idProp, idVal := range info.ids {
+				idKey := fmt.Sprintf("%s^%s", idProp, idVal)
+				if _, ok := idKeySet[idKey]; !ok {
+					idKeySet[idKey] = struct{}{}
+					idKeys = append(idKeys, idKey)
+				}
+			}
+		}
+		pairs = append(pairs, [2]*entityInfo{one, two})
+	}
+
+	// Resolve the IDs of the entities to DCIDs with the ReconIdMap cache.
+	idKeyToReconEntities, err := readReconEntities(ctx, store, idKeys)
+	if err != nil {
+		return nil, err
+	}
+	for _, pair := range pairs {
+		for _, info := range pair {
+			// Only rely on the highest ranked ID that is resolved.
+			for _, idProp := range rankedIDProps {
+				idVal, ok := info.ids[idProp]
+				if !ok {
+					continue
+				}
+				reconEntities, ok := idKeyToReconEntities[fmt.Sprintf("%s^%s", idProp, idVal)]
+				if !ok {
+					continue
+				}
+				for _, entity := range reconEntities.entities {
+					for _, id := range entity.GetIds() {
+						if id.GetProp() == "dcid" {
+							info.resolved[id.GetVal()] = struct{}{}
+						}
+					}
+				}
+				if idProp == "dcid" {
+					info.resolved[idVal] = struct{}{}
+				}
+				break
+			}
+		}
+	}
+
+	res := &pb.CompareEntitiesResponse{}
+	for _, pair := range pairs {
+		res.Comparisons = append(res.Comparisons,
+			&pb.CompareEntitiesResponse_Comparison{
+				SourceIds:   []string{pair[0].sourceID, pair[1].sourceID},
+				Probability: matchScore(pair[0], pair[1]),
+			})
+	}
+	return res, nil
+}
+
+// matchScore computes the heuristic score of two entities being the same.
+func matchScore(one, two *entityInfo) float64 {
+	logOdds := 0.0
+
+	// IDs.
+	idMatched, idConflicted := false, false
+	for idProp, idVal := range one.ids {
+		if val, ok := two.ids[idProp]; ok {
+			if val == idVal {
+				idMatched = true
+			} else {
+				idConflicted = true
+			}
+		}
+	}
+	if idMatched {
+		logOdds += idMatchLogOdds
+	} else if idConflicted {
+		logOdds += idConflictLogOdds
+	}
+	if len(one.resolved) > 0 && len(two.resolved) > 0 {
+		common := false
+		for dcid := range one.resolved {
+			if _, ok := two.resolved[dcid]; ok {
+				common = true
+				break
+			}
+		}
+		if common {
+			logOdds += resolvedMatchLogOdds
+		} else {
+			logOdds += resolvedConflictLogOdds
+		}
+	}
+
+	// Names.
+	if len(one.names) > 0 && len(two.names) > 0 {
+		similarity := 0.0
+		for _, n1 := range one.names {
+			for _, n2 := range two.names {
+				similarity = math.Max(similarity, nameSimilarity(n1, n2))
+			}
+		}
+		logOdds += nameMaxLogOdds * (2*similarity - 1)
+	}
+
+	// Coordinates.
+	if one.hasCoordinate && two.hasCoordinate {
+		d := distanceKm(one.lat, one.lng, two.lat, two.lng)
+		logOdds += math.Max(
+			coordinateMinLogOdds, coordinateMaxLogOdds*(1-d/coordinateNeutralKm))
+	}
+
+	p := 1 / (1 + math.Exp(-logOdds))
+	return math.Round(p*10000) / 10000
+}
+
+// normalizeName lower cases a name, and replaces punctuations with spaces.
+func normalizeName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return ' '
+	}, name)
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// nameSimilarity gets the similarity of two names in [0, 1], based on the edit
+// distance of the normalized names.
+func nameSimilarity(a, b string) float64 {
+	ra := []rune(normalizeName(a))
+	rb := []rune(normalizeName(b))
+	maxLen := len(ra)
+	if len(rb) > maxLen {
+		maxLen = len(rb)
+	}
+	if maxLen == 0 {
+		return 0
+	}
+	return 1 - float64(editDistance(ra, rb))/float64(maxLen)
+}
+
+// editDistance computes the Levenshtein distance.
+func editDistance(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// minInt gets the smallest of the values.
+func minInt(values ...int) int {
+	result := values[0]
+	for _, v := range values[1:] {
+		if v < result {
+			result = v
+		}
+	}
+	return result
+}
+
+// distanceKm computes the great-circle distance of two coordinates.
+func distanceKm(lat1, lng1, lat2, lng2 float64) float64 {
+	toRad := func(d float64) float64 { return d * math.Pi / 180 }
+	dLat := toRad(lat2 - lat1)
+	dLng := toRad(lng2 - lng1)
+	h := math.Pow(math.Sin(dLat/2), 2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Pow(math.Sin(dLng/2), 2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
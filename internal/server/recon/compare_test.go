@@ -0,0 +1,108 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recon
+
+import (
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNameSimilarity(t *testing.T) {
+	for _, c := range []struct {
+		a    string
+		b    string
+		want float64
+	}{
+		{"Mountain View", "mountain-view", 1},
+		{"abcd", "abce", 0.75},
+		{"", "abc", 0},
+	} {
+		if got := nameSimilarity(c.a, c.b); got != c.want {
+			t.Errorf("nameSimilarity(%s, %s) = %v, want %v", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestMatchScore(t *testing.T) {
+	subGraph := func(pvs map[string]string) *pb.EntitySubGraph {
+		node := &pb.McfGraph_PropertyValues{
+			Pvs: map[string]*pb.McfGraph_Values{},
+		}
+		for p, v := range pvs {
+			node.Pvs[p] = &pb.McfGraph_Values{
+				TypedValues: []*pb.McfGraph_TypedValue{{Value: proto.String(v)}},
+			}
+		}
+		return &pb.EntitySubGraph{
+			SourceId: "id",
+			GraphRepresentation: &pb.EntitySubGraph_SubGraph{
+				SubGraph: &pb.McfGraph{
+					Nodes: map[string]*pb.McfGraph_PropertyValues{"id": node},
+				},
+			},
+		}
+	}
+	for _, c := range []struct {
+		desc string
+		one  map[string]string
+		two  map[string]string
+		want float64
+	}{
+		{
+			"no evidence",
+			map[string]string{},
+			map[string]string{},
+			0.5,
+		},
+		{
+			"same id",
+			map[string]string{"geoId": "06"},
+			map[string]string{"geoId": "06", "name": "CA"},
+			0.9997,
+		},
+		{
+			"different id",
+			map[string]string{"geoId": "06"},
+			map[string]string{"geoId": "07"},
+			0.0003,
+		},
+		{
+			"same name and location",
+			map[string]string{"name": "Mountain View", "latitude": "37.4", "longitude": "-122.08"},
+			map[string]string{"name": "Mountain View", "latitude": "37.4", "longitude": "-122.08"},
+			0.9933,
+		},
+		{
+			"same name in different location",
+			map[string]string{"name": "Springfield", "latitude": "39.8", "longitude": "-89.65"},
+			map[string]string{"name": "Springfield", "latitude": "37.2", "longitude": "-93.29"},
+			0.2689,
+		},
+	} {
+		one, err := getEntityInfo(subGraph(c.one))
+		if err != nil {
+			t.Fatalf("getEntityInfo(%v) = %s", c.one, err)
+		}
+		two, err := getEntityInfo(subGraph(c.two))
+		if err != nil {
+			t.Fatalf("getEntityInfo(%v) = %s", c.two, err)
+		}
+		if got := matchScore(one, two); got != c.want {
+			t.Errorf("matchScore(%s) = %v, want %v", c.desc, got, c.want)
+		}
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/place"
 	"github.com/datacommonsorg/mixer/internal/server/v0/propertyvalue"
 	"github.com/datacommonsorg/mixer/internal/store"
 	"github.com/datacommonsorg/mixer/internal/store/bigtable"
@@ -32,13 +33,13 @@ const (
 	geoJSONPredicate string  = "geoJsonCoordinates"
 )
 
-// TODO(Spaceenter): Also add place types to the results.
-
 // ResolveCoordinates implements API for ReconServer.ResolveCoordinates.
 func ResolveCoordinates(
-	ctx context.Context, in *pb.ResolveCoordinatesRequest, store *store.Store) (
-	*pb.ResolveCoordinatesResponse, error,
-) {
+	ctx context.Context,
+	in *pb.ResolveCoordinatesRequest,
+	store *store.Store,
+	polygonCache *PolygonCache,
+) (*pb.ResolveCoordinatesResponse, error) {
 	// Map: lat^lng => normalized lat^lng.
 	normCoordinateMap := map[string]string{}
 	coordinateLookupKeys := map[string]struct{}{}
@@ -55,52 +56,33 @@ func ResolveCoordinates(
 	for key := range coordinateLookupKeys {
 		keyBody = append(keyBody, key)
 	}
-	reconDataList, err := bigtable.Read(
-		ctx,
-		store.BtGroup,
-		bigtable.BtCoordinateReconPrefix,
-		[][]string{keyBody},
-		func(jsonRaw []byte) (interface{}, error) {
-			var recon pb.CoordinateRecon
-			if err := proto.Unmarshal(jsonRaw, &recon); err != nil {
-				return nil, err
-			}
-			return &recon, nil
-		},
-	)
+	keyToPlaces, keyToTables, err := readCoordinateRecon(ctx, store, keyBody)
 	if err != nil {
 		return nil, err
 	}
 
-	// Collect places that don't fully cover the tiles that the coordinates are in.
-	questionablePlaces := map[string]struct{}{}
-	for _, reconData := range reconDataList {
-		for _, row := range reconData {
-			for _, place := range row.Data.(*pb.CoordinateRecon).GetPlaces() {
-				if _, ok := questionablePlaces[place.GetDcid()]; !ok && !place.GetFull() {
-					questionablePlaces[place.GetDcid()] = struct{}{}
-				}
+	// Collect the coordinates in places that don't fully cover their tiles.
+	questionablePlaces := map[string][]*pb.ResolveCoordinatesRequest_Coordinate{}
+	questionablePlaceList := []string{}
+	for _, co := range in.GetCoordinates() {
+		for _, place := range keyToPlaces[normCoordinateMap[coordinateKey(co)]] {
+			if place.GetFull() {
+				continue
 			}
-		}
-		// Only process data from one preferred import group.
-		if len(reconData) > 0 {
-			break
+			dcid := place.GetDcid()
+			if _, ok := questionablePlaces[dcid]; !ok {
+				questionablePlaceList = append(questionablePlaceList, dcid)
+			}
+			questionablePlaces[dcid] = append(questionablePlaces[dcid], co)
 		}
 	}
 
-	questionablePlaceList := []string{}
-	for place := range questionablePlaces {
-		questionablePlaceList = append(questionablePlaceList, place)
-	}
-	geoJSONData, err := propertyvalue.GetPropertyValuesHelper(
-		ctx, store, questionablePlaceList, geoJSONPredicate, true)
+	// Check containment place by place, so each polygon is parsed at most once.
+	polygons, err := getPolygons(ctx, store, polygonCache, questionablePlaceList)
 	if err != nil {
 		return nil, err
 	}
-	geoJSONMap := map[string]string{}
-	for place, entities := range geoJSONData {
-		geoJSONMap[place] = entities[0].Value
-	}
+	contained := containedCoordinates(polygons, questionablePlaces)
 
 	// Assemble response.
 	res := &pb.ResolveCoordinatesResponse{}
@@ -110,47 +92,177 @@ func ResolveCoordinates(
 			Latitude:  co.GetLatitude(),
 			Longitude: co.GetLongitude(),
 		}
-		for _, reconData := range reconDataList {
-			if len(reconData) == 0 {
-				continue
-			}
-			for _, row := range reconData {
-				if fmt.Sprintf("%s^%s", row.Parts[0], row.Parts[1]) != nKey {
+		for _, place := range keyToPlaces[nKey] {
+			if !place.GetFull() {
+				if _, ok := contained[place.GetDcid()+"^"+coordinateKey(co)]; !ok {
 					continue
 				}
-				for _, place := range row.Data.(*pb.CoordinateRecon).GetPlaces() {
-					if place.GetFull() {
-						placeCoordinates.PlaceDcids = append(
-							placeCoordinates.PlaceDcids,
-							place.GetDcid(),
-						)
-					} else { // Not fully cover the tile.
-						geoJSON, ok := geoJSONMap[place.GetDcid()]
-						if !ok {
-							continue
-						}
-						contained, err := isContainedIn(geoJSON, co.GetLatitude(), co.GetLongitude())
-						if err != nil {
-							return res, err
-						}
-						if contained {
-							placeCoordinates.PlaceDcids = append(
-								placeCoordinates.PlaceDcids,
-								place.GetDcid(),
-							)
-						}
-					}
-				}
 			}
-			// Only need data from a preferred import group.
-			break
+			placeCoordinates.PlaceDcids = append(
+				placeCoordinates.PlaceDcids,
+				place.GetDcid(),
+			)
 		}
+		placeCoordinates.ImportGroups = keyToTables[nKey]
 		res.PlaceCoordinates = append(res.PlaceCoordinates, placeCoordinates)
 	}
 
+	if err := addPlaceDetails(ctx, store, res, in.GetPlaceTypes()); err != nil {
+		return nil, err
+	}
 	return res, nil
 }
 
+// containedCoordinates checks the coordinates of each place against the place
+// polygon, and gets the set of dcid^lat^lng for the coordinates contained in
+// their places. Places without polygons contain no coordinates.
+func containedCoordinates(
+	polygons map[string]*s2.Polygon,
+	placeCoordinates map[string][]*pb.ResolveCoordinatesRequest_Coordinate,
+) map[string]struct{} {
+	result := map[string]struct{}{}
+	for dcid, coordinates := range placeCoordinates {
+		polygon, ok := polygons[dcid]
+		if !ok {
+			continue
+		}
+		for _, co := range coordinates {
+			s2Point := s2.PointFromLatLng(
+				s2.LatLngFromDegrees(co.GetLatitude(), co.GetLongitude()))
+			if polygon.ContainsPoint(s2Point) {
+				result[dcid+"^"+coordinateKey(co)] = struct{}{}
+			}
+		}
+	}
+	return result
+}
+
+// readCoordinateRecon reads the places of the tiles keyed by normalized
+// "lat^lng" from the coordinate recon cache.
+//
+// The places of each tile are merged from all the tables. The tables are in
+// rank order, so when tables disagree on whether a place fully covers a tile,
+// the higher ranked table wins. The import group tables of each tile are also
+// returned.
+func readCoordinateRecon(
+	ctx context.Context, store *store.Store, keys []string,
+) (map[string][]*pb.CoordinateRecon_Place, map[string][]string, error) {
+	keyToPlaces := map[string][]*pb.CoordinateRecon_Place{}
+	keyToTables := map[string][]string{}
+	if len(keys) == 0 {
+		return keyToPlaces, keyToTables, nil
+	}
+	reconDataList, tableNames, err := bigtable.Read(
+		ctx,
+		store.BtGroup,
+		bigtable.BtCoordinateReconPrefix,
+		[][]string{keys},
+		func(jsonRaw []byte) (interface{}, error) {
+			var recon pb.CoordinateRecon
+			if err := proto.Unmarshal(jsonRaw, &recon); err != nil {
+				return nil, err
+			}
+			return &recon, nil
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, reconData := range reconDataList {
+		for _, row := range reconData {
+			key := fmt.Sprintf("%s^%s", row.Parts[0], row.Parts[1])
+			places := row.Data.(*pb.CoordinateRecon).GetPlaces()
+			if len(places) == 0 {
+				continue
+			}
+			keyToTables[key] = append(keyToTables[key], tableNames[i])
+			for _, place := range places {
+				exist := false
+				for _, p := range keyToPlaces[key] {
+					if p.GetDcid() == place.GetDcid() {
+						exist = true
+						break
+					}
+				}
+				if !exist {
+					keyToPlaces[key] = append(keyToPlaces[key], place)
+				}
+			}
+		}
+	}
+	return keyToPlaces, keyToTables, nil
+}
+
+// addPlaceDetails adds the types, names and ancestors of the places to the
+// response, and only keeps the places of the wanted types if any.
+func addPlaceDetails(
+	ctx context.Context,
+	store *store.Store,
+	res *pb.ResolveCoordinatesResponse,
+	placeTypes []string,
+) error {
+	dcidSet := map[string]struct{}{}
+	dcids := []string{}
+	for _, co := range res.GetPlaceCoordinates() {
+		for _, dcid := range co.GetPlaceDcids() {
+			if _, ok := dcidSet[dcid]; !ok {
+				dcidSet[dcid] = struct{}{}
+				dcids = append(dcids, dcid)
+			}
+		}
+	}
+	if len(dcids) == 0 {
+		return nil
+	}
+	typeData, err := propertyvalue.GetPropertyValuesHelper(
+		ctx, store, dcids, "typeOf", true)
+	if err != nil {
+		return err
+	}
+	metadata, err := place.GetPlaceMetadataHelper(ctx, dcids, store)
+	if err != nil {
+		return err
+	}
+	for _, co := range res.GetPlaceCoordinates() {
+		setPlaces(co, typeData, metadata, placeTypes)
+	}
+	return nil
+}
+
+// setPlaces sets the places of a coordinate from the types and metadata of the
+// places, keeping those of the wanted types if any.
+func setPlaces(
+	co *pb.ResolveCoordinatesResponse_PlaceCoordinate,
+	typeData map[string][]*pb.EntityInfo,
+	metadata map[string]*pb.PlaceMetadata,
+	placeTypes []string,
+) {
+	placeDcids := []string{}
+	for _, dcid := range co.GetPlaceDcids() {
+		types := []string{}
+		for _, t := range typeData[dcid] {
+			types = append(types, t.GetDcid())
+		}
+		if len(placeTypes) > 0 && !hasAny(types, placeTypes) {
+			continue
+		}
+		placeDcids = append(placeDcids, dcid)
+		p := &pb.ResolveCoordinatesResponse_Place{Dcid: dcid, Types: types}
+		if m, ok := metadata[dcid]; ok {
+			p.Name = m.GetSelf().GetName()
+			for _, parent := range m.GetParents() {
+				p.Parents = append(p.Parents, &pb.EntityInfo{
+					Dcid:  parent.GetDcid(),
+					Name:  parent.GetName(),
+					Types: []string{parent.GetType()},
+				})
+			}
+		}
+		co.Places = append(co.Places, p)
+	}
+	co.PlaceDcids = placeDcids
+}
+
 func coordinateKey(c *pb.ResolveCoordinatesRequest_Coordinate) string {
 	return fmt.Sprintf("%f^%f", c.GetLatitude(), c.GetLongitude())
 }
@@ -252,12 +364,3 @@ func parseGeoJSON(geoJSON string) (*s2.Polygon, error) {
 		return nil, fmt.Errorf("unrecognized GeoJson object: %+v", g.Type)
 	}
 }
-
-func isContainedIn(geoJSON string, lat float64, lng float64) (bool, error) {
-	s2Polygon, err := parseGeoJSON(geoJSON)
-	if err != nil {
-		return false, err
-	}
-	s2Point := s2.PointFromLatLng(s2.LatLngFromDegrees(lat, lng))
-	return s2Polygon.ContainsPoint(s2Point), nil
-}
@@ -15,54 +15,128 @@
 package recon
 
 import (
+	"context"
 	"io/ioutil"
 	"path"
 	"runtime"
 	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"github.com/datacommonsorg/mixer/internal/store/bigtable"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
 )
 
-func TestIsContainedIn(t *testing.T) {
+func TestContainedCoordinates(t *testing.T) {
+	tables := []*bigtable.Table{bigtable.NewTable("frequent_2022", nil)}
+	testStore := &store.Store{BtGroup: bigtable.NewGroup(tables, "")}
+	cache := NewPolygonCache(10)
+	cache.sync(testStore.BtGroup.TableNames())
+	_, filename, _, _ := runtime.Caller(0)
+	for dcid, geoJSONFileName := range map[string]string{
+		"geoId/0649670": "mountain_view_geo_json.json",
+		"country/MEX":   "mexico_geo_json.json",
+	} {
+		geoJSONBytes, err := ioutil.ReadFile(
+			path.Join(path.Dir(filename), "test_data", geoJSONFileName))
+		if err != nil {
+			t.Fatalf("ioutil.ReadFile(%s) = %s", geoJSONFileName, err)
+		}
+		polygon, err := parseGeoJSON(string(geoJSONBytes))
+		if err != nil {
+			t.Fatalf("parseGeoJSON(%s) = %s", geoJSONFileName, err)
+		}
+		cache.add(dcid, polygon, testStore.BtGroup.TableNames())
+	}
+
+	coordinate := func(lat, lng float64) *pb.ResolveCoordinatesRequest_Coordinate {
+		return &pb.ResolveCoordinatesRequest_Coordinate{Latitude: lat, Longitude: lng}
+	}
+	placeCoordinates := map[string][]*pb.ResolveCoordinatesRequest_Coordinate{
+		"geoId/0649670": {coordinate(37.42, -122.08)},
+		"country/MEX":   {coordinate(32.41, -102.11), coordinate(26.55, -102.85)},
+	}
+	// The polygons are all cached, so they are not read from the tables.
+	polygons, err := getPolygons(
+		context.Background(), testStore, cache, []string{"geoId/0649670", "country/MEX"})
+	if err != nil {
+		t.Fatalf("getPolygons() = %s", err)
+	}
+	got := containedCoordinates(polygons, placeCoordinates)
+	want := map[string]struct{}{
+		"geoId/0649670^" + coordinateKey(coordinate(37.42, -122.08)): {},
+		"country/MEX^" + coordinateKey(coordinate(26.55, -102.85)):   {},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("containedCoordinates() got diff %v", diff)
+	}
+}
+
+func TestSetPlaces(t *testing.T) {
+	typeData := map[string][]*pb.EntityInfo{
+		"geoId/06":      {{Dcid: "State"}, {Dcid: "AdministrativeArea1"}},
+		"geoId/06085":   {{Dcid: "County"}},
+		"geoId/0649670": {{Dcid: "City"}},
+	}
+	metadata := map[string]*pb.PlaceMetadata{
+		"geoId/06085": {
+			Self: &pb.PlaceMetadata_PlaceInfo{
+				Dcid: "geoId/06085", Name: "Santa Clara County", Type: "County"},
+			Parents: []*pb.PlaceMetadata_PlaceInfo{
+				{Dcid: "geoId/06", Name: "California", Type: "State"},
+				{Dcid: "country/USA", Name: "United States", Type: "Country"},
+			},
+		},
+	}
 	for _, c := range []struct {
-		geoJSONFileName   string
-		lat               float64
-		lng               float64
-		wantIsContainedIn bool
+		placeTypes []string
+		want       *pb.ResolveCoordinatesResponse_PlaceCoordinate
 	}{
 		{
-			"mountain_view_geo_json.json",
-			37.42,
-			-122.08,
-			true,
+			nil,
+			&pb.ResolveCoordinatesResponse_PlaceCoordinate{
+				PlaceDcids: []string{"geoId/06", "geoId/06085", "geoId/0649670"},
+				Places: []*pb.ResolveCoordinatesResponse_Place{
+					{Dcid: "geoId/06", Types: []string{"State", "AdministrativeArea1"}},
+					{
+						Dcid:  "geoId/06085",
+						Name:  "Santa Clara County",
+						Types: []string{"County"},
+						Parents: []*pb.EntityInfo{
+							{Dcid: "geoId/06", Name: "California", Types: []string{"State"}},
+							{Dcid: "country/USA", Name: "United States", Types: []string{"Country"}},
+						},
+					},
+					{Dcid: "geoId/0649670", Types: []string{"City"}},
+				},
+			},
 		},
 		{
-			"mexico_geo_json.json",
-			32.41,
-			-102.11,
-			false,
-		},
-		{
-			"mexico_geo_json.json",
-			26.55,
-			-102.85,
-			true,
+			[]string{"County", "AdministrativeArea1"},
+			&pb.ResolveCoordinatesResponse_PlaceCoordinate{
+				PlaceDcids: []string{"geoId/06", "geoId/06085"},
+				Places: []*pb.ResolveCoordinatesResponse_Place{
+					{Dcid: "geoId/06", Types: []string{"State", "AdministrativeArea1"}},
+					{
+						Dcid:  "geoId/06085",
+						Name:  "Santa Clara County",
+						Types: []string{"County"},
+						Parents: []*pb.EntityInfo{
+							{Dcid: "geoId/06", Name: "California", Types: []string{"State"}},
+							{Dcid: "country/USA", Name: "United States", Types: []string{"Country"}},
+						},
+					},
+				},
+			},
 		},
 	} {
-		_, filename, _, _ := runtime.Caller(0)
-		geoJSONFilePath := path.Join(
-			path.Dir(filename), "test_data", c.geoJSONFileName)
-		geoJSONBytes, err := ioutil.ReadFile(geoJSONFilePath)
-		if err != nil {
-			t.Errorf("ioutil.ReadFile(%s) = %s", c.geoJSONFileName, err)
-			continue
-		}
-		contained, err := isContainedIn(string(geoJSONBytes), c.lat, c.lng)
-		if err != nil {
-			t.Errorf("isContainedIn(%s) = %s", c.geoJSONFileName, err)
-			continue
+		co := &pb.ResolveCoordinatesResponse_PlaceCoordinate{
+			PlaceDcids: []string{"geoId/06", "geoId/06085", "geoId/0649670"},
 		}
-		if contained != c.wantIsContainedIn {
-			t.Errorf("isContainedIn(%s) = %t, want %t",
-				c.geoJSONFileName, contained, c.wantIsContainedIn)
+		setPlaces(co, typeData, metadata, c.placeTypes)
+		if diff := cmp.Diff(co, c.want, protocmp.Transform()); diff != "" {
+			t.Errorf("setPlaces(%v) got diff %v", c.placeTypes, diff)
 		}
 	}
 }
@@ -23,9 +23,37 @@ import (
 	pb "github.com/datacommonsorg/mixer/internal/proto"
 	"github.com/datacommonsorg/mixer/test"
 	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
 )
 
+// placeEntity gets an entity with a name and coordinates.
+func placeEntity(sourceID, name, lat, lng string) *pb.EntitySubGraph {
+	text := func(v string) *pb.McfGraph_Values {
+		return &pb.McfGraph_Values{
+			TypedValues: []*pb.McfGraph_TypedValue{
+				{Type: pb.ValueType_TEXT.Enum(), Value: proto.String(v)},
+			},
+		}
+	}
+	return &pb.EntitySubGraph{
+		SourceId: sourceID,
+		GraphRepresentation: &pb.EntitySubGraph_SubGraph{
+			SubGraph: &pb.McfGraph{
+				Nodes: map[string]*pb.McfGraph_PropertyValues{
+					sourceID: {
+						Pvs: map[string]*pb.McfGraph_Values{
+							"name":      text(name),
+							"latitude":  text(lat),
+							"longitude": text(lng),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func TestCompareEntities(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -37,9 +65,25 @@ func TestCompareEntities(t *testing.T) {
 			req        *pb.CompareEntitiesRequest
 			goldenFile string
 		}{
-			// TODO(spaceenter): Add real test case.
 			{
-				&pb.CompareEntitiesRequest{},
+				&pb.CompareEntitiesRequest{
+					EntityPairs: []*pb.EntityPair{
+						// The same place with slightly different names and coordinates.
+						{
+							EntityOne: placeEntity(
+								"mountainView1", "Mountain View", "37.3861", "-122.0839"),
+							EntityTwo: placeEntity(
+								"mountainView2", "Mountain View, CA", "37.3894", "-122.0819"),
+						},
+						// Different places.
+						{
+							EntityOne: placeEntity(
+								"mountainView1", "Mountain View", "37.3861", "-122.0839"),
+							EntityTwo: placeEntity(
+								"paris", "Paris", "48.8566", "2.3522"),
+						},
+					},
+				},
 				"result.json",
 			},
 		} {
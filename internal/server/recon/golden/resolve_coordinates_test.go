@@ -53,6 +53,22 @@ func TestResolveCoordinates(t *testing.T) {
 				},
 				"result.json",
 			},
+			{
+				&pb.ResolveCoordinatesRequest{
+					Coordinates: []*pb.ResolveCoordinatesRequest_Coordinate{
+						{
+							Latitude:  37.42,
+							Longitude: -122.08,
+						},
+						{
+							Latitude:  32.41,
+							Longitude: -102.11,
+						},
+					},
+					PlaceTypes: []string{"County", "State"},
+				},
+				"place_types.json",
+			},
 		} {
 			resp, err := recon.ResolveCoordinates(ctx, c.req)
 			if err != nil {
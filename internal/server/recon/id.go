@@ -21,6 +21,7 @@ import (
 
 	"github.com/datacommonsorg/mixer/internal/store"
 	"github.com/datacommonsorg/mixer/internal/store/bigtable"
+	"github.com/datacommonsorg/mixer/internal/util"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
 	"google.golang.org/protobuf/proto"
@@ -39,7 +40,7 @@ func ResolveIds(
 	}
 
 	// Read cache data.
-	btDataList, err := bigtable.Read(
+	btDataList, tableNames, err := bigtable.Read(
 		ctx, store.BtGroup, bigtable.BtReconIDMapPrefix,
 		[][]string{{inProp}, ids, {outProp}},
 		func(jsonRaw []byte) (interface{}, error) {
@@ -54,34 +55,41 @@ func ResolveIds(
 		return nil, err
 	}
 
-	// Assemble result.
-	// TODO: merge ids from different tables. Now it only picks up IDs from
-	// one preferred table.
+	// Assemble result, merging the IDs from all the tables. The tables are in
+	// rank order.
 	res := &pb.ResolveIdsResponse{}
-	existData := map[string]bool{}
-	for _, btData := range btDataList {
+	entities := map[string]*pb.ResolveIdsResponse_Entity{}
+	for i, btData := range btDataList {
 		for _, row := range btData {
 			inID := row.Parts[1]
-			if exist, ok := existData[inID]; ok && exist {
-				continue
-			}
 			reconEntitiesPb, ok := row.Data.(*pb.ReconEntities)
 			if !ok {
 				continue
 			}
-			entity := &pb.ResolveIdsResponse_Entity{InId: inID}
+			entity, ok := entities[inID]
+			if !ok {
+				entity = &pb.ResolveIdsResponse_Entity{InId: inID}
+				entities[inID] = entity
+				res.Entities = append(res.Entities, entity)
+			}
 			for _, reconEntity := range reconEntitiesPb.GetEntities() {
 				if len(reconEntity.GetIds()) != 1 {
 					return nil, fmt.Errorf("wrong cache result for %s: %v", inID, row.Data)
 				}
-				entity.OutIds = append(entity.OutIds, reconEntity.GetIds()[0].GetVal())
+				outID := reconEntity.GetIds()[0].GetVal()
+				if !util.StringContainedIn(outID, entity.OutIds) {
+					entity.OutIds = append(entity.OutIds, outID)
+				}
+			}
+			if len(reconEntitiesPb.GetEntities()) > 0 {
+				entity.ImportGroups = append(entity.ImportGroups, tableNames[i])
 			}
-			existData[inID] = true
-			// Sort to make the result deterministic.
-			sort.Strings(entity.OutIds)
-			res.Entities = append(res.Entities, entity)
 		}
 	}
+	for _, entity := range res.Entities {
+		// Sort to make the result deterministic.
+		sort.Strings(entity.OutIds)
+	}
 	// Sort to make the result deterministic.
 	sort.Slice(res.Entities, func(i, j int) bool {
 		return res.Entities[i].GetInId() > res.Entities[j].GetInId()
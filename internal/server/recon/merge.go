@@ -0,0 +1,162 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recon
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"github.com/datacommonsorg/mixer/internal/store/bigtable"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// mergedEntities holds the recon entities of an ID merged from all the import
+// group tables.
+type mergedEntities struct {
+	entities []*pb.ReconEntities_Entity
+	// The import group tables of each entity, ordered by rank.
+	importGroups [][]string
+	// Rank of the highest ranked table of each entity, 0 being the highest.
+	ranks []int
+	// Entity key -> index in entities.
+	index map[string]int
+}
+
+func newMergedEntities() *mergedEntities {
+	return &mergedEntities{index: map[string]int{}}
+}
+
+// entityKey identifies a recon entity by its DCID, or by all its IDs when
+// there is no DCID.
+func entityKey(entity *pb.ReconEntities_Entity) string {
+	ids := []string{}
+	for _, id := range entity.GetIds() {
+		if id.GetProp() == "dcid" {
+			return id.GetVal()
+		}
+		ids = append(ids, fmt.Sprintf("%s:%s", id.GetProp(), id.GetVal()))
+	}
+	sort.Strings(ids)
+	return strings.Join(ids, ",")
+}
+
+// add merges an entity from a table of the given rank. Tables need to be added
+// in rank order. When the same entity is in multiple tables, the IDs of a lower
+// ranked table are only added for the properties that are not in the higher
+// ranked tables.
+func (m *mergedEntities) add(entity *pb.ReconEntities_Entity, table string, rank int) {
+	key := entityKey(entity)
+	i, ok := m.index[key]
+	if !ok {
+		m.index[key] = len(m.entities)
+		m.entities = append(m.entities, proto.Clone(entity).(*pb.ReconEntities_Entity))
+		m.importGroups = append(m.importGroups, []string{table})
+		m.ranks = append(m.ranks, rank)
+		return
+	}
+	merged := m.entities[i]
+	props := map[string]struct{}{}
+	for _, id := range merged.GetIds() {
+		props[id.GetProp()] = struct{}{}
+	}
+	for _, id := range entity.GetIds() {
+		if _, ok := props[id.GetProp()]; !ok {
+			merged.Ids = append(merged.Ids, id)
+		}
+	}
+	if groups := m.importGroups[i]; groups[len(groups)-1] != table {
+		m.importGroups[i] = append(groups, table)
+	}
+}
+
+// weights gets the prior weight of each entity when the ID resolves to
+// different entities in different tables. The weight halves for each rank
+// below the highest ranked table, so the entity of a higher ranked table is
+// preferred.
+func (m *mergedEntities) weights() []float64 {
+	result := []float64{}
+	for _, rank := range m.ranks {
+		result = append(result, math.Pow(0.5, float64(rank-m.ranks[0])))
+	}
+	return result
+}
+
+// probabilities gets the probability of each entity from the weights.
+func (m *mergedEntities) probabilities() []float64 {
+	weights := m.weights()
+	total := 0.0
+	for _, w := range weights {
+		total += w
+	}
+	for i := range weights {
+		weights[i] = math.Round(weights[i]/total*10000) / 10000
+	}
+	return weights
+}
+
+// readReconEntities reads the ReconIdMap cache for ID keys in the form of
+// "<idProp>^<idVal>", and returns the recon entities merged from all the
+// import group tables keyed by ID key.
+func readReconEntities(
+	ctx context.Context, store *store.Store, idKeys []string,
+) (map[string]*mergedEntities, error) {
+	result := map[string]*mergedEntities{}
+	if len(idKeys) == 0 {
+		return result, nil
+	}
+	btDataList, tableNames, err := bigtable.Read(
+		ctx,
+		store.BtGroup,
+		bigtable.BtReconIDMapPrefix,
+		[][]string{idKeys},
+		func(jsonRaw []byte) (interface{}, error) {
+			var reconEntities pb.ReconEntities
+			if err := proto.Unmarshal(jsonRaw, &reconEntities); err != nil {
+				return nil, err
+			}
+			return &reconEntities, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	// The tables are in rank order.
+	for i, btData := range btDataList {
+		for _, row := range btData {
+			if len(row.Parts) < 2 {
+				return nil, status.Errorf(codes.Internal, "Invalid id key %v", row.Parts)
+			}
+			idKey := fmt.Sprintf("%s^%s", row.Parts[0], row.Parts[1])
+			reconEntities, ok := row.Data.(*pb.ReconEntities)
+			if !ok || len(reconEntities.GetEntities()) == 0 {
+				continue
+			}
+			if _, ok := result[idKey]; !ok {
+				result[idKey] = newMergedEntities()
+			}
+			for _, entity := range reconEntities.GetEntities() {
+				result[idKey].add(entity, tableNames[i], i)
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recon
+
+import (
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func reconEntity(ids ...string) *pb.ReconEntities_Entity {
+	result := &pb.ReconEntities_Entity{}
+	for i := 0; i < len(ids); i += 2 {
+		result.Ids = append(result.Ids,
+			&pb.ReconEntities_Entity_ID{Prop: ids[i], Val: ids[i+1]})
+	}
+	return result
+}
+
+func TestMergedEntities(t *testing.T) {
+	m := newMergedEntities()
+	m.add(reconEntity("dcid", "geoId/06", "wikidataId", "Q99"), "frequent", 0)
+	m.add(reconEntity("dcid", "geoId/06", "wikidataId", "Q100", "isoCode", "US-CA"), "biomedical", 1)
+	m.add(reconEntity("isoCode", "US-WA"), "biomedical", 1)
+	m.add(reconEntity("isoCode", "US-WA"), "infrequent", 2)
+
+	wantEntities := []*pb.ReconEntities_Entity{
+		reconEntity("dcid", "geoId/06", "wikidataId", "Q99", "isoCode", "US-CA"),
+		reconEntity("isoCode", "US-WA"),
+	}
+	if diff := cmp.Diff(m.entities, wantEntities, protocmp.Transform()); diff != "" {
+		t.Errorf("mergedEntities.entities got diff %v", diff)
+	}
+	wantImportGroups := [][]string{
+		{"frequent", "biomedical"},
+		{"biomedical", "infrequent"},
+	}
+	if diff := cmp.Diff(m.importGroups, wantImportGroups); diff != "" {
+		t.Errorf("mergedEntities.importGroups got diff %v", diff)
+	}
+}
+
+func TestMergedEntitiesConflict(t *testing.T) {
+	// The same wikidataId resolves to different DCIDs in different tables.
+	m := newMergedEntities()
+	m.add(reconEntity("dcid", "geoId/06", "wikidataId", "Q99"), "frequent", 0)
+	m.add(reconEntity("dcid", "geoId/07", "wikidataId", "Q99"), "biomedical", 1)
+	m.add(reconEntity("dcid", "geoId/08", "wikidataId", "Q99"), "infrequent", 2)
+	m.add(reconEntity("dcid", "geoId/09", "wikidataId", "Q99"), "infrequent", 2)
+
+	want := []float64{0.5, 0.25, 0.125, 0.125}
+	if diff := cmp.Diff(m.probabilities(), want); diff != "" {
+		t.Errorf("mergedEntities.probabilities() got diff %v", diff)
+	}
+
+	same := newMergedEntities()
+	same.add(reconEntity("dcid", "geoId/06", "wikidataId", "Q99"), "frequent", 0)
+	same.add(reconEntity("dcid", "geoId/07", "wikidataId", "Q99"), "frequent", 0)
+	want = []float64{0.5, 0.5}
+	if diff := cmp.Diff(same.probabilities(), want); diff != "" {
+		t.Errorf("mergedEntities.probabilities() of the same table got diff %v", diff)
+	}
+}
@@ -0,0 +1,282 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recon
+
+import (
+	"context"
+	"log"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"github.com/datacommonsorg/mixer/internal/store/bigtable"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// Candidates with lower name similarity are not returned.
+	minNameSimilarity = 0.7
+	// The weight of a candidate is exp(nameWeightScale * (similarity - 1)), so
+	// an exact match weighs e^nameWeightScale times a totally different name.
+	nameWeightScale = 10.0
+	// Extra log weight for candidates that satisfy the containment constraints.
+	containmentLogWeight = 2.0
+	// Maximum number of candidates returned for a name.
+	maxNameCandidates = 5
+	// Names are indexed by the prefixes of their tokens, so misspelled names
+	// still share keys with the correct ones.
+	tokenPrefixLength = 3
+)
+
+// NameEntry is an entity in the name index.
+type NameEntry struct {
+	Dcid string
+	// Name and alternate names.
+	Names       []string
+	Types       []string
+	ContainedIn []string
+}
+
+// NameIndex is an in-memory index of entity names for name-based recon.
+type NameIndex struct {
+	entries []*NameEntry
+	// Prefix of normalized name token -> indexes of entries.
+	tokens map[string][]int
+	// DCID -> index of the entry.
+	dcids map[string]int
+}
+
+// NameCandidate is a resolved entity of a name.
+type NameCandidate struct {
+	Entry       *NameEntry
+	Probability float64
+}
+
+// NewNameIndex builds a name index from the entries.
+func NewNameIndex(entries []*NameEntry) *NameIndex {
+	index := &NameIndex{
+		entries: entries,
+		tokens:  map[string][]int{},
+		dcids:   map[string]int{},
+	}
+	for i, e := range entries {
+		index.dcids[e.Dcid] = i
+		seen := map[string]struct{}{}
+		for _, name := range e.Names {
+			for _, key := range tokenKeys(name) {
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				seen[key] = struct{}{}
+				index.tokens[key] = append(index.tokens[key], i)
+			}
+		}
+	}
+	return index
+}
+
+// tokenKeys gets the index keys of a name.
+func tokenKeys(name string) []string {
+	result := []string{}
+	for _, token := range strings.Fields(normalizeName(name)) {
+		if r := []rune(token); len(r) > tokenPrefixLength {
+			token = string(r[:tokenPrefixLength])
+		}
+		result = append(result, token)
+	}
+	return result
+}
+
+// LoadNameIndex builds the name index from the place metadata cache in all the
+// import group tables, which has the name, type and ancestors of each place.
+// A place in multiple tables is taken from the higher ranked one.
+func LoadNameIndex(ctx context.Context, store *store.Store) (*NameIndex, error) {
+	defer util.TimeTrack(time.Now(), "LoadNameIndex")
+	btDataList, _, err := bigtable.ReadPrefix(
+		ctx,
+		store.BtGroup,
+		bigtable.BtPlacesMetadataPrefix,
+		func(jsonRaw []byte) (interface{}, error) {
+			var data pb.PlaceMetadataCache
+			if err := proto.Unmarshal(jsonRaw, &data); err != nil {
+				return nil, err
+			}
+			return &data, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	entries := []*NameEntry{}
+	seen := map[string]struct{}{}
+	for _, btData := range btDataList {
+		for _, row := range btData {
+			if len(row.Parts) == 0 {
+				continue
+			}
+			dcid := row.Parts[0]
+			if _, ok := seen[dcid]; ok {
+				continue
+			}
+			entry := placeNameEntry(dcid, row.Data.(*pb.PlaceMetadataCache))
+			if entry == nil {
+				continue
+			}
+			seen[dcid] = struct{}{}
+			entries = append(entries, entry)
+		}
+	}
+	log.Printf("Number of entities in name index: %d", len(entries))
+	return NewNameIndex(entries), nil
+}
+
+// placeNameEntry gets the name entry of a place from its metadata cache, with
+// all its ancestors as the places it is contained in. It returns nil when the
+// place has no name.
+func placeNameEntry(dcid string, data *pb.PlaceMetadataCache) *NameEntry {
+	places := map[string]*pb.PlaceMetadataCache_PlaceInfo{}
+	for _, place := range data.GetPlaces() {
+		places[place.GetDcid()] = place
+	}
+	self, ok := places[dcid]
+	if !ok || self.GetName() == "" {
+		return nil
+	}
+	entry := &NameEntry{Dcid: dcid, Names: []string{self.GetName()}}
+	if self.GetType() != "" {
+		entry.Types = []string{self.GetType()}
+	}
+	visited := map[string]struct{}{dcid: {}}
+	queue := append([]string{}, self.GetParents()...)
+	for len(queue) > 0 {
+		parent := queue[0]
+		queue = queue[1:]
+		if _, ok := visited[parent]; ok {
+			continue
+		}
+		visited[parent] = struct{}{}
+		entry.ContainedIn = append(entry.ContainedIn, parent)
+		queue = append(queue, places[parent].GetParents()...)
+	}
+	return entry
+}
+
+// nameSimilarityOf gets the highest similarity of a name to the names of an
+// entry.
+func nameSimilarityOf(name string, entry *NameEntry) float64 {
+	result := 0.0
+	for _, n := range entry.Names {
+		result = math.Max(result, nameSimilarity(name, n))
+	}
+	return result
+}
+
+func hasAny(values []string, wanted []string) bool {
+	for _, v := range values {
+		if util.StringContainedIn(v, wanted) {
+			return true
+		}
+	}
+	return false
+}
+
+// Resolve finds the entities with names similar to the given name, ranked by
+// probability.
+//
+// When types are given, only the entities of one of the types are returned.
+// When containedIn places are given, entities known to be in other places are
+// dropped, and those in one of the places are preferred.
+func (index *NameIndex) Resolve(
+	name string, types, containedIn []string,
+) []*NameCandidate {
+	if index == nil {
+		return nil
+	}
+	// Entries sharing an index key are the candidates.
+	candidates := map[int]struct{}{}
+	for _, key := range tokenKeys(name) {
+		for _, i := range index.tokens[key] {
+			candidates[i] = struct{}{}
+		}
+	}
+	result := []*NameCandidate{}
+	for i := range candidates {
+		entry := index.entries[i]
+		if len(types) > 0 && !hasAny(entry.Types, types) {
+			continue
+		}
+		logWeight := 0.0
+		if len(containedIn) > 0 && len(entry.ContainedIn) > 0 {
+			if !hasAny(entry.ContainedIn, containedIn) {
+				continue
+			}
+			logWeight += containmentLogWeight
+		}
+		similarity := nameSimilarityOf(name, entry)
+		if similarity < minNameSimilarity {
+			continue
+		}
+		weight := math.Exp(logWeight + nameWeightScale*(similarity-1))
+		result = append(result, &NameCandidate{Entry: entry, Probability: weight})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Probability == result[j].Probability {
+			return result[i].Entry.Dcid < result[j].Entry.Dcid
+		}
+		return result[i].Probability > result[j].Probability
+	})
+	// Keep the top candidates before normalizing, so the returned probabilities
+	// sum to 1.
+	if len(result) > maxNameCandidates {
+		result = result[:maxNameCandidates]
+	}
+	total := 0.0
+	for _, c := range result {
+		total += c.Probability
+	}
+	for _, c := range result {
+		c.Probability = math.Round(c.Probability/total*10000) / 10000
+	}
+	return result
+}
+
+// rankByName gets the probabilities of the entities that an ID resolves to,
+// from their prior weights times the similarity of their indexed names to the
+// given name. It returns nil when the name or any entity name is unknown.
+func (index *NameIndex) rankByName(name string, dcids []string, priors []float64) []float64 {
+	if index == nil || name == "" {
+		return nil
+	}
+	weights := []float64{}
+	total := 0.0
+	for _, dcid := range dcids {
+		i, ok := index.dcids[dcid]
+		if !ok {
+			return nil
+		}
+		w := priors[len(weights)] *
+			math.Exp(nameWeightScale*(nameSimilarityOf(name, index.entries[i])-1))
+		weights = append(weights, w)
+		total += w
+	}
+	for i := range weights {
+		weights[i] = math.Round(weights[i]/total*10000) / 10000
+	}
+	return weights
+}
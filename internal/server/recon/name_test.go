@@ -0,0 +1,154 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recon
+
+import (
+	"fmt"
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNameIndexResolve(t *testing.T) {
+	index := NewNameIndex([]*NameEntry{
+		{
+			Dcid:        "geoId/1714000",
+			Names:       []string{"Chicago"},
+			Types:       []string{"City"},
+			ContainedIn: []string{"geoId/17"},
+		},
+		{
+			Dcid:        "geoId/17031",
+			Names:       []string{"Cook County"},
+			Types:       []string{"County"},
+			ContainedIn: []string{"geoId/17"},
+		},
+		{
+			Dcid:        "geoId/1724582",
+			Names:       []string{"Springfield"},
+			Types:       []string{"City"},
+			ContainedIn: []string{"geoId/17"},
+		},
+		{
+			Dcid:        "geoId/2970000",
+			Names:       []string{"Springfield"},
+			Types:       []string{"City"},
+			ContainedIn: []string{"geoId/29"},
+		},
+		{
+			Dcid:  "geoId/2571000",
+			Names: []string{"Springfeld"},
+			Types: []string{"City"},
+		},
+	})
+
+	type result struct {
+		Dcid        string
+		Probability float64
+	}
+	for _, c := range []struct {
+		name        string
+		types       []string
+		containedIn []string
+		want        []result
+	}{
+		{
+			"chicago",
+			nil,
+			nil,
+			[]result{{"geoId/1714000", 1}},
+		},
+		{
+			"Cook County",
+			[]string{"City"},
+			nil,
+			[]result{},
+		},
+		{
+			"Springfield",
+			[]string{"City"},
+			nil,
+			[]result{
+				{"geoId/1724582", 0.4162},
+				{"geoId/2970000", 0.4162},
+				{"geoId/2571000", 0.1677},
+			},
+		},
+		{
+			"Springfield",
+			nil,
+			[]string{"geoId/29"},
+			[]result{
+				{"geoId/2970000", 0.9483},
+				{"geoId/2571000", 0.0517},
+			},
+		},
+	} {
+		got := []result{}
+		for _, candidate := range index.Resolve(c.name, c.types, c.containedIn) {
+			got = append(got, result{candidate.Entry.Dcid, candidate.Probability})
+		}
+		if diff := cmp.Diff(got, c.want); diff != "" {
+			t.Errorf("Resolve(%s) got diff %v", c.name, diff)
+		}
+	}
+
+	// Probabilities are normalized over the returned candidates.
+	entries := []*NameEntry{}
+	for i := 0; i < maxNameCandidates+2; i++ {
+		entries = append(entries, &NameEntry{
+			Dcid:  fmt.Sprintf("geoId/%02d", i),
+			Names: []string{"Springfield"},
+		})
+	}
+	got := []result{}
+	for _, candidate := range NewNameIndex(entries).Resolve("Springfield", nil, nil) {
+		got = append(got, result{candidate.Entry.Dcid, candidate.Probability})
+	}
+	want := []result{
+		{"geoId/00", 0.2},
+		{"geoId/01", 0.2},
+		{"geoId/02", 0.2},
+		{"geoId/03", 0.2},
+		{"geoId/04", 0.2},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Resolve(Springfield) with many candidates got diff %v", diff)
+	}
+}
+
+func TestPlaceNameEntry(t *testing.T) {
+	data := &pb.PlaceMetadataCache{
+		Places: []*pb.PlaceMetadataCache_PlaceInfo{
+			{Dcid: "geoId/1714000", Name: "Chicago", Type: "City", Parents: []string{"geoId/17031", "geoId/17"}},
+			{Dcid: "geoId/17031", Name: "Cook County", Type: "County", Parents: []string{"geoId/17"}},
+			{Dcid: "geoId/17", Name: "Illinois", Type: "State", Parents: []string{"country/USA"}},
+			{Dcid: "country/USA", Name: "United States", Type: "Country"},
+		},
+	}
+	want := &NameEntry{
+		Dcid:        "geoId/1714000",
+		Names:       []string{"Chicago"},
+		Types:       []string{"City"},
+		ContainedIn: []string{"geoId/17031", "geoId/17", "country/USA"},
+	}
+	if diff := cmp.Diff(placeNameEntry("geoId/1714000", data), want); diff != "" {
+		t.Errorf("placeNameEntry() got diff %v", diff)
+	}
+	if got := placeNameEntry("geoId/06", data); got != nil {
+		t.Errorf("placeNameEntry() of a missing place = %v, want nil", got)
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recon
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/v0/propertyvalue"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"github.com/golang/geo/s2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxRegionTiles is the maximum number of coordinate recon tiles that a region
+// can span, which is about 20 by 20 degrees.
+const maxRegionTiles = 10000
+
+// ResolvePolygon implements API for ReconServer.ResolvePolygon.
+func ResolvePolygon(
+	ctx context.Context,
+	in *pb.ResolvePolygonRequest,
+	store *store.Store,
+	polygonCache *PolygonCache,
+) (*pb.ResolvePolygonResponse, error) {
+	region, err := parseGeoJSON(in.GetGeoJson())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid geo_json: %s", err)
+	}
+	return resolveRegion(ctx, store, polygonCache, region, in.GetPlaceTypes(), in.GetContainedOnly())
+}
+
+// ResolveBoundingBox implements API for ReconServer.ResolveBoundingBox.
+func ResolveBoundingBox(
+	ctx context.Context,
+	in *pb.ResolveBoundingBoxRequest,
+	store *store.Store,
+	polygonCache *PolygonCache,
+) (*pb.ResolvePolygonResponse, error) {
+	region, err := boundingBoxPolygon(
+		in.GetMinLatitude(), in.GetMinLongitude(),
+		in.GetMaxLatitude(), in.GetMaxLongitude())
+	if err != nil {
+		return nil, err
+	}
+	return resolveRegion(ctx, store, polygonCache, region, in.GetPlaceTypes(), in.GetContainedOnly())
+}
+
+// boundingBoxPolygon builds the polygon of a bounding box.
+func boundingBoxPolygon(minLat, minLng, maxLat, maxLng float64) (*s2.Polygon, error) {
+	// Negated so that NaN values are rejected too.
+	if !(minLat >= -90 && maxLat <= 90 && minLng >= -180 && maxLng <= 180) {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Bounding box is out of range: (%f, %f), (%f, %f)", minLat, minLng, maxLat, maxLng)
+	}
+	if !(minLat < maxLat && minLng < maxLng) {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Bounding box needs min < max: (%f, %f), (%f, %f)", minLat, minLng, maxLat, maxLng)
+	}
+	// Counter-clockwise, so the inside of the loop is the box.
+	loop := s2.LoopFromPoints([]s2.Point{
+		s2.PointFromLatLng(s2.LatLngFromDegrees(minLat, minLng)),
+		s2.PointFromLatLng(s2.LatLngFromDegrees(minLat, maxLng)),
+		s2.PointFromLatLng(s2.LatLngFromDegrees(maxLat, maxLng)),
+		s2.PointFromLatLng(s2.LatLngFromDegrees(maxLat, minLng)),
+	})
+	return s2.PolygonFromLoops([]*s2.Loop{loop}), nil
+}
+
+// regionTileKeys gets the keys of the coordinate recon tiles that cover the
+// bounding rectangle of a region.
+func regionTileKeys(region *s2.Polygon) ([]string, error) {
+	rect := region.RectBound()
+	tileIndex := func(degree, offset float64) int {
+		return int((degree + offset) / gridSize)
+	}
+	latLo := tileIndex(rect.Lo().Lat.Degrees(), 90)
+	latHi := tileIndex(rect.Hi().Lat.Degrees(), 90)
+	lngLo := tileIndex(rect.Lo().Lng.Degrees(), 180)
+	lngHi := tileIndex(rect.Hi().Lng.Degrees(), 180)
+	if rect.Lng.IsInverted() {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Regions crossing the antimeridian are not supported")
+	}
+	if n := (latHi - latLo + 1) * (lngHi - lngLo + 1); n > maxRegionTiles {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Region is too large: spans %d tiles, the limit is %d", n, maxRegionTiles)
+	}
+	keys := []string{}
+	for i := latLo; i <= latHi; i++ {
+		for j := lngLo; j <= lngHi; j++ {
+			keys = append(keys, fmt.Sprintf("%.1f^%.1f",
+				float64(i)*gridSize-90, float64(j)*gridSize-180))
+		}
+	}
+	return keys, nil
+}
+
+// placeRelation gets how a place polygon relates to a region.
+func placeRelation(region, place *s2.Polygon) pb.ResolvePolygonResponse_Relation {
+	if region.Contains(place) {
+		return pb.ResolvePolygonResponse_CONTAINED
+	}
+	if region.Intersects(place) {
+		return pb.ResolvePolygonResponse_INTERSECTS
+	}
+	return pb.ResolvePolygonResponse_RELATION_UNSPECIFIED
+}
+
+// resolveRegion finds the places that intersect or are contained in a region.
+//
+// The candidates are the places in the coordinate recon tiles of the region,
+// and are checked against the region with their geoJSON coordinates. Places
+// without geoJSON coordinates are not returned.
+func resolveRegion(
+	ctx context.Context,
+	store *store.Store,
+	polygonCache *PolygonCache,
+	region *s2.Polygon,
+	placeTypes []string,
+	containedOnly bool,
+) (*pb.ResolvePolygonResponse, error) {
+	keys, err := regionTileKeys(region)
+	if err != nil {
+		return nil, err
+	}
+	keyToPlaces, _, err := readCoordinateRecon(ctx, store, keys)
+	if err != nil {
+		return nil, err
+	}
+	candidateSet := map[string]struct{}{}
+	candidates := []string{}
+	for _, places := range keyToPlaces {
+		for _, place := range places {
+			if _, ok := candidateSet[place.GetDcid()]; !ok {
+				candidateSet[place.GetDcid()] = struct{}{}
+				candidates = append(candidates, place.GetDcid())
+			}
+		}
+	}
+	res := &pb.ResolvePolygonResponse{}
+	if len(candidates) == 0 {
+		return res, nil
+	}
+
+	// Filter by type first, to read fewer geoJSON coordinates.
+	typeData, err := propertyvalue.GetPropertyValuesHelper(
+		ctx, store, candidates, "typeOf", true)
+	if err != nil {
+		return nil, err
+	}
+	dcidToTypes := map[string][]string{}
+	dcids := []string{}
+	for _, dcid := range candidates {
+		types := []string{}
+		for _, t := range typeData[dcid] {
+			types = append(types, t.GetDcid())
+		}
+		if len(placeTypes) > 0 && !hasAny(types, placeTypes) {
+			continue
+		}
+		dcidToTypes[dcid] = types
+		dcids = append(dcids, dcid)
+	}
+	if len(dcids) == 0 {
+		return res, nil
+	}
+
+	polygons, err := getPolygons(ctx, store, polygonCache, dcids)
+	if err != nil {
+		return nil, err
+	}
+	nameData, err := propertyvalue.GetPropertyValuesHelper(
+		ctx, store, dcids, "name", true)
+	if err != nil {
+		return nil, err
+	}
+	for _, dcid := range dcids {
+		polygon, ok := polygons[dcid]
+		if !ok {
+			continue
+		}
+		relation := placeRelation(region, polygon)
+		if relation == pb.ResolvePolygonResponse_RELATION_UNSPECIFIED {
+			continue
+		}
+		if containedOnly && relation != pb.ResolvePolygonResponse_CONTAINED {
+			continue
+		}
+		place := &pb.ResolvePolygonResponse_Place{
+			Dcid:     dcid,
+			Types:    dcidToTypes[dcid],
+			Relation: relation,
+		}
+		if names := nameData[dcid]; len(names) > 0 {
+			place.Name = names[0].GetValue()
+		}
+		res.Places = append(res.Places, place)
+	}
+	sort.Slice(res.Places, func(i, j int) bool {
+		return res.Places[i].GetDcid() < res.Places[j].GetDcid()
+	})
+	return res, nil
+}
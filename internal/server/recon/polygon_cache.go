@@ -0,0 +1,163 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recon
+
+import (
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/datacommonsorg/mixer/internal/server/v0/propertyvalue"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"github.com/datacommonsorg/mixer/internal/store/bigtable"
+	"github.com/golang/geo/s2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PolygonCache is a concurrency-safe LRU cache of the parsed geoJSON
+// coordinates of places, keyed by place DCID.
+//
+// The cache is cleared when the Bigtable tables change, for example after a
+// branch table update, as the coordinates might have changed.
+type PolygonCache struct {
+	lock     sync.Mutex
+	capacity int
+	// Most recently used entries are at the front.
+	order   *list.List
+	entries map[string]*list.Element
+	// Joined names of the tables that the cached polygons are read from.
+	tables string
+}
+
+type polygonEntry struct {
+	dcid    string
+	polygon *s2.Polygon
+}
+
+// NewPolygonCache creates a polygon cache that holds at most capacity polygons.
+func NewPolygonCache(capacity int) *PolygonCache {
+	return &PolygonCache{
+		capacity: capacity,
+		order:    list.New(),
+		entries:  map[string]*list.Element{},
+	}
+}
+
+// Len gets the number of cached polygons.
+func (c *PolygonCache) Len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.order.Len()
+}
+
+// sync clears the cache when the tables differ from the ones of the cached
+// polygons.
+func (c *PolygonCache) sync(tableNames []string) {
+	tables := strings.Join(tableNames, ",")
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if tables != c.tables {
+		c.order.Init()
+		c.entries = map[string]*list.Element{}
+		c.tables = tables
+	}
+}
+
+func (c *PolygonCache) get(dcid string) (*s2.Polygon, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	elem, ok := c.entries[dcid]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*polygonEntry).polygon, true
+}
+
+// add caches a polygon read from the given tables. It is dropped when the
+// cache has been synced to other tables since the read.
+func (c *PolygonCache) add(dcid string, polygon *s2.Polygon, tableNames []string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.capacity <= 0 || strings.Join(tableNames, ",") != c.tables {
+		return
+	}
+	if elem, ok := c.entries[dcid]; ok {
+		elem.Value.(*polygonEntry).polygon = polygon
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[dcid] = c.order.PushFront(&polygonEntry{dcid: dcid, polygon: polygon})
+	for c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*polygonEntry).dcid)
+	}
+}
+
+// getPolygons gets the parsed geoJSON coordinates of places, from the cache
+// when possible. Each missing place is read and parsed once. Places without
+// geoJSON coordinates are not in the result. The cache can be nil, and is not
+// used by requests that only read some of the import groups.
+func getPolygons(
+	ctx context.Context,
+	store *store.Store,
+	cache *PolygonCache,
+	dcids []string,
+) (map[string]*s2.Polygon, error) {
+	if bigtable.FiltersImportGroups(ctx) {
+		cache = nil
+	}
+	result := map[string]*s2.Polygon{}
+	missing := []string{}
+	tableNames := store.BtGroup.TableNames()
+	if cache != nil {
+		cache.sync(tableNames)
+	}
+	for _, dcid := range dcids {
+		if cache != nil {
+			if polygon, ok := cache.get(dcid); ok {
+				result[dcid] = polygon
+				continue
+			}
+		}
+		missing = append(missing, dcid)
+	}
+	if len(missing) == 0 {
+		return result, nil
+	}
+	geoJSONData, err := propertyvalue.GetPropertyValuesHelper(
+		ctx, store, missing, geoJSONPredicate, true)
+	if err != nil {
+		return nil, err
+	}
+	for dcid, entities := range geoJSONData {
+		if len(entities) == 0 {
+			continue
+		}
+		polygon, err := parseGeoJSON(entities[0].GetValue())
+		if err != nil {
+			return nil, status.Errorf(
+				codes.Internal, "Invalid geoJSON coordinates of %s: %s", dcid, err)
+		}
+		result[dcid] = polygon
+		if cache != nil {
+			cache.add(dcid, polygon, tableNames)
+		}
+	}
+	return result, nil
+}
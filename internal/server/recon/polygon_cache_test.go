@@ -0,0 +1,95 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/datacommonsorg/mixer/internal/store"
+	"github.com/datacommonsorg/mixer/internal/store/bigtable"
+	"github.com/golang/geo/s2"
+)
+
+func TestPolygonCache(t *testing.T) {
+	polygon := &s2.Polygon{}
+	tables := []string{"frequent_2022", "infrequent_2022"}
+	cache := NewPolygonCache(2)
+	cache.sync(tables)
+	cache.add("geoId/06", polygon, tables)
+	cache.add("geoId/08", polygon, tables)
+	// Use geoId/06 so geoId/08 is the least recently used.
+	if _, ok := cache.get("geoId/06"); !ok {
+		t.Errorf("get(geoId/06) got no polygon")
+	}
+	cache.add("geoId/10", polygon, tables)
+	if _, ok := cache.get("geoId/08"); ok {
+		t.Errorf("get(geoId/08) got evicted polygon")
+	}
+	for _, dcid := range []string{"geoId/06", "geoId/10"} {
+		if got, ok := cache.get(dcid); !ok || got != polygon {
+			t.Errorf("get(%s) = %v, %t, want cached polygon", dcid, got, ok)
+		}
+	}
+
+	// Same tables keep the cache.
+	cache.sync(tables)
+	if got := cache.Len(); got != 2 {
+		t.Errorf("Len() = %d after sync with the same tables, want 2", got)
+	}
+	// A new branch table clears the cache.
+	cache.sync([]string{"dcbranch_2022", "frequent_2022", "infrequent_2022"})
+	if got := cache.Len(); got != 0 {
+		t.Errorf("Len() = %d after table change, want 0", got)
+	}
+	// A polygon read from the old tables is not cached.
+	cache.add("geoId/06", polygon, tables)
+	if got := cache.Len(); got != 0 {
+		t.Errorf("Len() = %d after adding a polygon of old tables, want 0", got)
+	}
+
+	disabled := NewPolygonCache(0)
+	disabled.add("geoId/06", polygon, nil)
+	if got := disabled.Len(); got != 0 {
+		t.Errorf("Len() of disabled cache = %d, want 0", got)
+	}
+}
+
+func TestGetPolygonsImportGroupFilter(t *testing.T) {
+	tables := []*bigtable.Table{bigtable.NewTableWithBackend("frequent_2022", nil)}
+	testStore := &store.Store{BtGroup: bigtable.NewGroup(tables, "")}
+	cache := NewPolygonCache(10)
+	cache.sync(testStore.BtGroup.TableNames())
+	cache.add("geoId/06", &s2.Polygon{}, testStore.BtGroup.TableNames())
+
+	got, err := getPolygons(context.Background(), testStore, cache, []string{"geoId/06"})
+	if err != nil {
+		t.Fatalf("getPolygons() = %s", err)
+	}
+	if _, ok := got["geoId/06"]; !ok {
+		t.Errorf("getPolygons() got no cached polygon")
+	}
+
+	// A request that excludes import groups does not read the shared cache.
+	ctx := bigtable.WithImportGroupFilter(
+		context.Background(), &bigtable.ImportGroupFilter{Deny: []string{"frequent"}})
+	got, err = getPolygons(ctx, testStore, cache, []string{"geoId/06"})
+	if err != nil {
+		t.Fatalf("getPolygons() with filter = %s", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("getPolygons() with filter = %v, want no polygon", got)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recon
+
+import (
+	"math"
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRegionTileKeys(t *testing.T) {
+	region, err := boundingBoxPolygon(37.25, -122.1, 37.5, -121.9)
+	if err != nil {
+		t.Fatalf("boundingBoxPolygon() = %s", err)
+	}
+	got, err := regionTileKeys(region)
+	if err != nil {
+		t.Fatalf("regionTileKeys() = %s", err)
+	}
+	want := []string{
+		"37.2^-122.2", "37.2^-122.0",
+		"37.4^-122.2", "37.4^-122.0",
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("regionTileKeys() got diff %v", diff)
+	}
+
+	large, err := boundingBoxPolygon(0, 0, 30, 30)
+	if err != nil {
+		t.Fatalf("boundingBoxPolygon() = %s", err)
+	}
+	if _, err := regionTileKeys(large); err == nil {
+		t.Errorf("regionTileKeys() of a large region got no error")
+	}
+}
+
+func TestBoundingBoxPolygonInvalid(t *testing.T) {
+	for _, box := range [][4]float64{
+		{10, 10, 5, 20},
+		{10, 10, 20, 10},
+		{-91, 10, 20, 20},
+		{10, 10, 20, 181},
+		{math.NaN(), 10, 20, 20},
+	} {
+		if _, err := boundingBoxPolygon(box[0], box[1], box[2], box[3]); err == nil {
+			t.Errorf("boundingBoxPolygon(%v) got no error", box)
+		}
+	}
+}
+
+func TestPlaceRelation(t *testing.T) {
+	region, err := parseGeoJSON(
+		`{"type": "Polygon", "coordinates": [[[0,0],[10,0],[10,10],[0,10],[0,0]]]}`)
+	if err != nil {
+		t.Fatalf("parseGeoJSON() = %s", err)
+	}
+	for _, c := range []struct {
+		geoJSON string
+		want    pb.ResolvePolygonResponse_Relation
+	}{
+		{
+			`{"type": "Polygon", "coordinates": [[[2,2],[4,2],[4,4],[2,4],[2,2]]]}`,
+			pb.ResolvePolygonResponse_CONTAINED,
+		},
+		{
+			`{"type": "Polygon", "coordinates": [[[8,8],[12,8],[12,12],[8,12],[8,8]]]}`,
+			pb.ResolvePolygonResponse_INTERSECTS,
+		},
+		{
+			`{"type": "MultiPolygon", "coordinates": [
+				[[[2,2],[4,2],[4,4],[2,4],[2,2]]],
+				[[[20,20],[22,20],[22,22],[20,22],[20,20]]]]}`,
+			pb.ResolvePolygonResponse_INTERSECTS,
+		},
+		{
+			`{"type": "Polygon", "coordinates": [[[20,20],[22,20],[22,22],[20,22],[20,20]]]}`,
+			pb.ResolvePolygonResponse_RELATION_UNSPECIFIED,
+		},
+	} {
+		place, err := parseGeoJSON(c.geoJSON)
+		if err != nil {
+			t.Errorf("parseGeoJSON(%s) = %s", c.geoJSON, err)
+			continue
+		}
+		if got := placeRelation(region, place); got != c.want {
+			t.Errorf("placeRelation(%s) = %v, want %v", c.geoJSON, got, c.want)
+		}
+	}
+}
@@ -22,10 +22,6 @@ import (
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
 	"github.com/datacommonsorg/mixer/internal/store"
-	"github.com/datacommonsorg/mixer/internal/store/bigtable"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -63,14 +59,61 @@ func getPropVal(node *pb.McfGraph_PropertyValues, prop string) string {
 	return typedValues[0].GetValue()
 }
 
+// Get all the values of a given property.
+func getPropVals(node *pb.McfGraph_PropertyValues, prop string) []string {
+	result := []string{}
+	for _, v := range node.GetPvs()[prop].GetTypedValues() {
+		if v.GetValue() != "" {
+			result = append(result, v.GetValue())
+		}
+	}
+	return result
+}
+
+// nameQuery holds the properties of an entity used for name-based recon.
+type nameQuery struct {
+	name        string
+	types       []string
+	containedIn []string
+}
+
+// getNameQuery reads the name, types and containing places of an entity
+// subgraph node. A containing place referred as another node of the subgraph
+// is represented by its DCID.
+func getNameQuery(
+	graph *pb.McfGraph, node *pb.McfGraph_PropertyValues,
+) *nameQuery {
+	q := &nameQuery{name: getPropVal(node, "name")}
+	for _, t := range getPropVals(node, "typeOf") {
+		q.types = append(q.types, strings.TrimPrefix(t, "dcs:"))
+	}
+	for _, v := range getPropVals(node, "containedInPlace") {
+		if parent, ok := graph.GetNodes()[v]; ok {
+			v = getPropVal(parent, "dcid")
+			if v == "" {
+				continue
+			}
+		}
+		q.containedIn = append(q.containedIn, strings.TrimPrefix(v, "dcid:"))
+	}
+	return q
+}
+
 // ResolveEntities implements API for ReconServer.ResolveEntities.
+//
+// Entities are resolved by their IDs. Those without any resolved ID are
+// resolved by their names with the name index, if it is not nil.
 func ResolveEntities(
-	ctx context.Context, in *pb.ResolveEntitiesRequest, store *store.Store,
+	ctx context.Context,
+	in *pb.ResolveEntitiesRequest,
+	store *store.Store,
+	nameIndex *NameIndex,
 ) (
 	*pb.ResolveEntitiesResponse, error) {
 	idKeyToSourceIDs := map[string][]string{}
 	sourceIDs := map[string]struct{}{}
 	idKeys := []string{}
+	nameQueries := map[string]*nameQuery{}
 
 	// Collect to-be-resolved IDs to rowList and idKeyToSourceID.
 	for _, entity := range in.GetEntities() {
@@ -84,6 +127,7 @@ func ResolveEntities(
 			if !ok {
 				continue
 			}
+			nameQueries[sourceID] = getNameQuery(entity.GetSubGraph(), node)
 			for _, idProp := range rankedIDProps {
 				idVal := getPropVal(node, idProp)
 				if idVal == "" {
@@ -114,65 +158,33 @@ func ResolveEntities(
 	}
 
 	// Read ReconIdMap cache.
-	btDataList, err := bigtable.Read(
-		ctx,
-		store.BtGroup,
-		bigtable.BtReconIDMapPrefix,
-		[][]string{idKeys},
-		func(jsonRaw []byte) (interface{}, error) {
-			var reconEntities pb.ReconEntities
-			if err := proto.Unmarshal(jsonRaw, &reconEntities); err != nil {
-				return nil, err
-			}
-			return &reconEntities, nil
-		},
-	)
+	idKeyToReconEntities, err := readReconEntities(ctx, store, idKeys)
 	if err != nil {
 		return nil, err
 	}
 
-	// Source ID -> ID Prop -> ReconEntities.
-	reconEntityStore := map[string]map[string]*pb.ReconEntities{}
+	// Source ID -> ID Prop -> merged ReconEntities.
+	reconEntityStore := map[string]map[string]*mergedEntities{}
 
 	// Group resolving cache result by source ID.
-	for _, btData := range btDataList {
-		if len(btData) == 0 {
+	for idKey, reconEntities := range idKeyToReconEntities {
+		sourceIDs, ok := idKeyToSourceIDs[idKey]
+		if !ok {
 			continue
 		}
-		for _, row := range btData {
-			idKey := fmt.Sprintf("%s^%s", row.Parts[0], row.Parts[1])
-			reconEntities := row.Data
-			if reconEntities == nil {
-				continue
-			}
-			sourceIDs, ok := idKeyToSourceIDs[idKey]
-			if !ok {
-				continue
-			}
-			parts := strings.Split(idKey, "^")
-			if len(parts) != 2 {
-				return nil, status.Errorf(codes.Internal, "Invalid id key %s", idKey)
-			}
-			idProp := parts[0]
-
-			for _, sourceID := range sourceIDs {
-				if _, ok := reconEntityStore[sourceID]; !ok {
-					reconEntityStore[sourceID] = map[string]*pb.ReconEntities{}
-				}
-				if re := reconEntities.(*pb.ReconEntities); len(re.GetEntities()) > 0 {
-					reconEntityStore[sourceID][idProp] = re
-				}
+		idProp := strings.Split(idKey, "^")[0]
+		for _, sourceID := range sourceIDs {
+			if _, ok := reconEntityStore[sourceID]; !ok {
+				reconEntityStore[sourceID] = map[string]*mergedEntities{}
 			}
+			reconEntityStore[sourceID][idProp] = reconEntities
 		}
-		// Only process data from one preferred import group.
-		// TODO: merge entities from differente import groups.
-		break
 	}
 
 	// Assemble response.
 	res := &pb.ResolveEntitiesResponse{}
 	for sourceID, idProp2ReconEntities := range reconEntityStore {
-		var reconEntities *pb.ReconEntities
+		var reconEntities *mergedEntities
 		for _, idProp := range rankedIDProps {
 			if val, ok := idProp2ReconEntities[idProp]; ok {
 				reconEntities = val
@@ -183,16 +195,29 @@ func ResolveEntities(
 			continue
 		}
 
-		// If it is resolved to multiple DC entities, each resolved entity has an equal probability.
-		probability := float64(1.0 / len(reconEntities.GetEntities()))
+		// If it is resolved to multiple DC entities, entities of higher ranked
+		// import group tables are preferred, and they are further ranked by
+		// their names.
+		probabilities := reconEntities.probabilities()
+		if q, ok := nameQueries[sourceID]; ok && len(reconEntities.entities) > 1 {
+			dcids := []string{}
+			for _, entity := range reconEntities.entities {
+				dcids = append(dcids, entityKey(entity))
+			}
+			if byName := nameIndex.rankByName(
+				q.name, dcids, reconEntities.weights()); byName != nil {
+				probabilities = byName
+			}
+		}
 
 		resolvedEntity := &pb.ResolveEntitiesResponse_ResolvedEntity{
 			SourceId: sourceID,
 		}
 
-		for _, entity := range reconEntities.GetEntities() {
+		for i, entity := range reconEntities.entities {
 			resolvedID := &pb.ResolveEntitiesResponse_ResolvedId{
-				Probability: probability,
+				Probability:  probabilities[i],
+				ImportGroups: reconEntities.importGroups[i],
 			}
 			for _, id := range entity.GetIds() {
 				resolvedID.Ids = append(resolvedID.Ids,
@@ -207,15 +232,25 @@ func ResolveEntities(
 		res.ResolvedEntities = append(res.ResolvedEntities, resolvedEntity)
 	}
 
-	// Add entities that are not resolved as empty result.
+	// Resolve the rest of the entities by names, and add those that are not
+	// resolved as empty result.
 	for sourceID := range sourceIDs {
 		if _, ok := reconEntityStore[sourceID]; ok { // Resolved.
 			continue
 		}
-		res.ResolvedEntities = append(res.ResolvedEntities,
-			&pb.ResolveEntitiesResponse_ResolvedEntity{
-				SourceId: sourceID,
-			})
+		resolvedEntity := &pb.ResolveEntitiesResponse_ResolvedEntity{
+			SourceId: sourceID,
+		}
+		if q, ok := nameQueries[sourceID]; ok && q.name != "" {
+			for _, c := range nameIndex.Resolve(q.name, q.types, q.containedIn) {
+				resolvedEntity.ResolvedIds = append(resolvedEntity.ResolvedIds,
+					&pb.ResolveEntitiesResponse_ResolvedId{
+						Ids:         []*pb.IdWithProperty{{Prop: "dcid", Val: c.Entry.Dcid}},
+						Probability: c.Probability,
+					})
+			}
+		}
+		res.ResolvedEntities = append(res.ResolvedEntities, resolvedEntity)
 	}
 
 	// Sort to make the result deterministic.
@@ -16,30 +16,128 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
 	"log"
 	"path"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync"
 
 	pubsub "cloud.google.com/go/pubsub"
 	"cloud.google.com/go/storage"
 	"github.com/datacommonsorg/mixer/internal/parser/mcf"
 	dcpubsub "github.com/datacommonsorg/mixer/internal/pubsub"
+	"github.com/datacommonsorg/mixer/internal/server/healthcheck"
+	"github.com/datacommonsorg/mixer/internal/server/ranking"
+	"github.com/datacommonsorg/mixer/internal/server/recon"
 	"github.com/datacommonsorg/mixer/internal/server/resource"
 	"github.com/datacommonsorg/mixer/internal/server/statvar"
 	"github.com/datacommonsorg/mixer/internal/store"
 	"github.com/datacommonsorg/mixer/internal/store/bigtable"
 	"github.com/datacommonsorg/mixer/internal/translator/solver"
 	"github.com/datacommonsorg/mixer/internal/translator/types"
+	"github.com/datacommonsorg/mixer/internal/util"
 )
 
 // Server holds resources for a mixer server
 type Server struct {
 	store    *store.Store
 	metadata *resource.Metadata
-	cache    *resource.Cache
+	// The cache is rebuilt when the import group tables change.
+	cache     *cacheRef
+	cacheLock sync.RWMutex
+	// Name index for name-based entity recon. It is rebuilt when the import
+	// group tables change.
+	nameIndex     *recon.NameIndex
+	nameIndexLock sync.RWMutex
+	// Parsed geoJSON coordinates of places for coordinate recon.
+	polygonCache *recon.PolygonCache
+}
+
+// cacheRef counts the requests that use a cache, so the SQLite index of a
+// replaced cache is only closed after these requests finish.
+type cacheRef struct {
+	cache *resource.Cache
+	users sync.WaitGroup
+}
+
+// getCache gets the current cache.
+func (s *Server) getCache() *resource.Cache {
+	s.cacheLock.RLock()
+	defer s.cacheLock.RUnlock()
+	return s.cache.cache
+}
+
+// useCache gets the current cache for a request. The returned function must be
+// called when the request is done with the cache.
+func (s *Server) useCache() (*resource.Cache, func()) {
+	s.cacheLock.RLock()
+	defer s.cacheLock.RUnlock()
+	ref := s.cache
+	ref.users.Add(1)
+	return ref.cache, ref.users.Done
+}
+
+// rebuildCache rebuilds the cache from the current tables, with the same
+// search indexes as the current cache.
+func (s *Server) rebuildCache(ctx context.Context) error {
+	current := s.getCache()
+	if current == nil {
+		return nil
+	}
+	cache, err := NewCache(ctx, s.store, SearchOptions{
+		UseSearch:           current.SvgSearchIndex != nil || current.SQLiteDb != nil,
+		BuildSvgSearchIndex: current.SvgSearchIndex != nil,
+		BuildSqliteIndex:    current.SQLiteDb != nil,
+	})
+	if err != nil {
+		return err
+	}
+	s.cacheLock.Lock()
+	old := s.cache
+	s.cache = &cacheRef{cache: cache}
+	s.cacheLock.Unlock()
+	ranking.SetParentSvg(cache.ParentSvg)
+	if current.SQLiteDb != nil {
+		// No new request can use the old cache, so close its index once the
+		// requests that got it are done.
+		go func() {
+			old.users.Wait()
+			if err := current.SQLiteDb.Close(); err != nil {
+				log.Printf("Failed to close the SQLite index: %v", err)
+			}
+		}()
+	}
+	return nil
+}
+
+// getNameIndex gets the current name index.
+func (s *Server) getNameIndex() *recon.NameIndex {
+	s.nameIndexLock.RLock()
+	defer s.nameIndexLock.RUnlock()
+	return s.nameIndex
+}
+
+// buildNameIndex builds a name index from the current tables, or returns nil
+// when name-based entity recon is disabled.
+func (s *Server) buildNameIndex(ctx context.Context) (*recon.NameIndex, error) {
+	if s.getNameIndex() == nil {
+		return nil, nil
+	}
+	return recon.LoadNameIndex(ctx, s.store)
+}
+
+// ProbeSQLiteIndex checks that the SQLite index of the current cache can be
+// queried.
+func (s *Server) ProbeSQLiteIndex(ctx context.Context) error {
+	cache, done := s.useCache()
+	defer done()
+	if cache == nil || cache.SQLiteDb == nil {
+		return fmt.Errorf("no SQLite index")
+	}
+	return healthcheck.SQLProbe(cache.SQLiteDb, "SELECT COUNT(*) FROM statvars")(ctx)
 }
 
 func (s *Server) updateBranchTable(ctx context.Context, branchTableName string) {
@@ -56,11 +154,15 @@ func (s *Server) updateBranchTable(ctx context.Context, branchTableName string)
 // ReadBranchTableName reads branch cache folder from GCS.
 func ReadBranchTableName(
 	ctx context.Context, bucket, versionFile string) (string, error) {
+	return readGCSFile(ctx, bucket, versionFile)
+}
+
+func readGCSFile(ctx context.Context, bucket, object string) (string, error) {
 	client, err := storage.NewClient(ctx)
 	if err != nil {
 		return "", err
 	}
-	rc, err := client.Bucket(bucket).Object(versionFile).NewReader(ctx)
+	rc, err := client.Bucket(bucket).Object(object).NewReader(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -72,6 +174,130 @@ func ReadBranchTableName(
 	return string(folder), nil
 }
 
+// ImportGroupUpdateOptions configures the updates of the import group tables.
+type ImportGroupUpdateOptions struct {
+	PubsubProject    string
+	SubscriberPrefix string
+	PubsubTopic      string
+	// Path of the manifest that lists the import group tables, as a GCS path
+	// like "gs://<bucket>/<object>" or a local file path.
+	Manifest   string
+	BtProject  string
+	BtInstance string
+}
+
+// ReadImportGroupManifest reads the import group tables from a manifest, which
+// has one table name per line.
+func ReadImportGroupManifest(ctx context.Context, manifest string) ([]string, error) {
+	var content string
+	if strings.HasPrefix(manifest, "gs://") {
+		parts := strings.SplitN(strings.TrimPrefix(manifest, "gs://"), "/", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid GCS path of manifest: %s", manifest)
+		}
+		var err error
+		content, err = readGCSFile(ctx, parts[0], parts[1])
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		b, err := ioutil.ReadFile(manifest)
+		if err != nil {
+			return nil, err
+		}
+		content = string(b)
+	}
+	tableNames := util.ParseBigtableGroup(content)
+	if len(tableNames) == 0 {
+		return nil, fmt.Errorf("no import group table in manifest %s", manifest)
+	}
+	return tableNames, nil
+}
+
+// updateImportGroupTables replaces the import group tables with the tables of
+// the names. Existing tables are kept, and new tables are created by
+// newTable. The new tables need to pass a probe read, otherwise the current
+// tables are kept. The cache and the name index are rebuilt from the new
+// tables.
+func (s *Server) updateImportGroupTables(
+	ctx context.Context,
+	tableNames []string,
+	newTable func(name string) (*bigtable.Table, error),
+) error {
+	previous := s.store.BtGroup.ImportGroupTables()
+	current := map[string]*bigtable.Table{}
+	for _, t := range previous {
+		current[t.Name()] = t
+	}
+	tables := []*bigtable.Table{}
+	added := []*bigtable.Table{}
+	for _, name := range tableNames {
+		if t, ok := current[name]; ok {
+			tables = append(tables, t)
+			continue
+		}
+		t, err := newTable(name)
+		if err != nil {
+			return err
+		}
+		tables = append(tables, t)
+		added = append(added, t)
+	}
+	if err := bigtable.NewGroup(added, "").Probe(ctx); err != nil {
+		return fmt.Errorf("new import group tables failed probe read: %v", err)
+	}
+	s.store.BtGroup.UpdateImportGroupTables(tables)
+	nameIndex, err := s.buildNameIndex(ctx)
+	if err != nil {
+		// Roll back, so the tables match the name index that is kept.
+		s.store.BtGroup.UpdateImportGroupTables(previous)
+		return fmt.Errorf("failed to rebuild name index from the new tables: %v", err)
+	}
+	if err := s.rebuildCache(ctx); err != nil {
+		// Roll back, so the tables match the cache that is kept.
+		s.store.BtGroup.UpdateImportGroupTables(previous)
+		return fmt.Errorf("failed to rebuild cache from the new tables: %v", err)
+	}
+	if nameIndex != nil {
+		s.nameIndexLock.Lock()
+		s.nameIndex = nameIndex
+		s.nameIndexLock.Unlock()
+	}
+	return nil
+}
+
+// SubscribeImportGroupUpdate subscribes for import group table updates. On each
+// message, the tables in the manifest replace the current import group tables.
+func (s *Server) SubscribeImportGroupUpdate(
+	ctx context.Context, opts ImportGroupUpdateOptions,
+) error {
+	newTable := func(name string) (*bigtable.Table, error) {
+		t, err := bigtable.NewBtTable(ctx, opts.BtProject, opts.BtInstance, name)
+		if err != nil {
+			return nil, err
+		}
+		return bigtable.NewTable(name, t), nil
+	}
+	return dcpubsub.Subscribe(
+		ctx,
+		opts.PubsubProject,
+		opts.SubscriberPrefix,
+		opts.PubsubTopic,
+		func(ctx context.Context, msg *pubsub.Message) error {
+			tableNames, err := ReadImportGroupManifest(ctx, opts.Manifest)
+			if err != nil {
+				return err
+			}
+			log.Printf("Import group update received with tables: %v", tableNames)
+			if err := s.updateImportGroupTables(ctx, tableNames, newTable); err != nil {
+				return err
+			}
+			log.Printf("Updated import group tables to %v", s.store.BtGroup.TableNames())
+			return nil
+		},
+	)
+}
+
 // NewMetadata initialize the metadata for translator.
 func NewMetadata(
 	bqDataset, storeProject, branchInstance, schemaPath string) (*resource.Metadata, error) {
@@ -174,11 +400,22 @@ func NewMixerServer(
 	return &Server{
 		store:    store,
 		metadata: metadata,
-		cache:    cache,
+		cache:    &cacheRef{cache: cache},
 	}
 }
 
-// NewReconServer creates a new recon server instance.
-func NewReconServer(store *store.Store) *Server {
-	return &Server{store: store}
+// NewReconServer creates a new recon server instance. The name index can be
+// nil, which disables name-based entity recon. The polygon cache can be nil,
+// which disables caching of parsed place polygons.
+func NewReconServer(
+	store *store.Store,
+	nameIndex *recon.NameIndex,
+	polygonCache *recon.PolygonCache,
+) *Server {
+	return &Server{
+		store:        store,
+		cache:        &cacheRef{},
+		nameIndex:    nameIndex,
+		polygonCache: polygonCache,
+	}
 }
@@ -0,0 +1,90 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build sqlite_fts5
+// +build sqlite_fts5
+
+// The SQLite index needs the FTS5 extension, so these tests run with
+// -tags sqlite_fts5.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/resource"
+	"github.com/datacommonsorg/mixer/internal/server/statvar"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"github.com/datacommonsorg/mixer/internal/store/bigtable"
+)
+
+func TestRebuildCacheDuringQueries(t *testing.T) {
+	ctx := context.Background()
+	sqliteDb, err := statvar.BuildSQLiteIndex(map[string]*pb.StatVarGroupNode{})
+	if err != nil {
+		t.Fatalf("BuildSQLiteIndex() = %s", err)
+	}
+	s := NewMixerServer(store.NewStore(nil, nil, []*bigtable.Table{
+		bigtable.NewTableWithBackend("frequent_2022_01", bigtable.NewLocalBackend(
+			map[string][]byte{"d/9/geoId/06": nil})),
+	}, ""), nil, &resource.Cache{SQLiteDb: sqliteDb})
+
+	// A request that got the cache before the rebuild can still query it.
+	cache, done := s.useCache()
+	if err := s.rebuildCache(ctx); err != nil {
+		t.Fatalf("rebuildCache() = %s", err)
+	}
+	if err := cache.SQLiteDb.PingContext(ctx); err != nil {
+		t.Errorf("SQLite index closed while in use: %s", err)
+	}
+	done()
+
+	// Queries that run at the same time as rebuilds do not fail.
+	stop := make(chan struct{})
+	errs := make(chan error, 1)
+	go func() {
+		defer close(errs)
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			if err := s.ProbeSQLiteIndex(ctx); err != nil {
+				errs <- err
+				return
+			}
+		}
+	}()
+	for i := 0; i < 10; i++ {
+		if err := s.rebuildCache(ctx); err != nil {
+			t.Fatalf("rebuildCache() = %s", err)
+		}
+	}
+	close(stop)
+	if err := <-errs; err != nil {
+		t.Errorf("ProbeSQLiteIndex() during rebuild = %s", err)
+	}
+
+	// The index of the replaced cache is closed once the request is done.
+	for i := 0; cache.SQLiteDb.PingContext(ctx) == nil; i++ {
+		if i == 100 {
+			t.Fatalf("SQLite index of the replaced cache is not closed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -16,10 +16,23 @@ package server
 
 import (
 	"context"
+	"errors"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/ranking"
+	"github.com/datacommonsorg/mixer/internal/server/recon"
+	"github.com/datacommonsorg/mixer/internal/server/resource"
 	"github.com/datacommonsorg/mixer/internal/store"
+	"github.com/datacommonsorg/mixer/internal/store/bigtable"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestNoBigTable(t *testing.T) {
@@ -32,3 +45,263 @@ func TestNoBigTable(t *testing.T) {
 		t.Errorf("Error invalid: %s", err)
 	}
 }
+
+type failingBackend struct{}
+
+func (failingBackend) ReadRows(
+	ctx context.Context, keys []string, fn func(string, []byte) bool,
+) error {
+	return errors.New("unreachable")
+}
+
+func (failingBackend) ReadPrefix(
+	ctx context.Context, prefix string, fn func(string, []byte) bool,
+) error {
+	return errors.New("unreachable")
+}
+
+// probeOnlyBackend is a backend that passes the probe read, but fails other
+// reads.
+type probeOnlyBackend struct{}
+
+func (probeOnlyBackend) ReadRows(
+	ctx context.Context, keys []string, fn func(string, []byte) bool,
+) error {
+	return errors.New("unreachable")
+}
+
+func (probeOnlyBackend) ReadPrefix(
+	ctx context.Context, prefix string, fn func(string, []byte) bool,
+) error {
+	fn(prefix+"key", nil)
+	return nil
+}
+
+func TestUpdateImportGroupTables(t *testing.T) {
+	ctx := context.Background()
+	localTable := func(name string) *bigtable.Table {
+		return bigtable.NewTableWithBackend(name, bigtable.NewLocalBackend(
+			map[string][]byte{"d/9/geoId/06": nil}))
+	}
+	s := NewMixerServer(store.NewStore(nil, nil, []*bigtable.Table{
+		localTable("frequent_2022_01"),
+		localTable("infrequent_2022_01"),
+		localTable("dcbranch_2022_01"),
+	}, "dcbranch_2022_01"), nil, &resource.Cache{})
+	cache := s.getCache()
+
+	created := []string{}
+	newTable := func(name string) (*bigtable.Table, error) {
+		created = append(created, name)
+		return localTable(name), nil
+	}
+	err := s.updateImportGroupTables(ctx,
+		[]string{"frequent_2022_02", "infrequent_2022_01", "ipcc_2022_01"}, newTable)
+	if err != nil {
+		t.Fatalf("updateImportGroupTables() = %s", err)
+	}
+	if diff := cmp.Diff(created, []string{"frequent_2022_02", "ipcc_2022_01"}); diff != "" {
+		t.Errorf("updateImportGroupTables() created tables diff %v", diff)
+	}
+	want := []string{
+		"dcbranch_2022_01", "frequent_2022_02", "ipcc_2022_01", "infrequent_2022_01"}
+	if diff := cmp.Diff(s.store.BtGroup.TableNames(), want); diff != "" {
+		t.Errorf("TableNames() got diff %v", diff)
+	}
+	if s.getCache() == cache {
+		t.Errorf("updateImportGroupTables() did not rebuild the cache")
+	}
+	cache = s.getCache()
+
+	// A new table that fails the probe read keeps the current tables.
+	err = s.updateImportGroupTables(ctx,
+		[]string{"frequent_2022_03"},
+		func(name string) (*bigtable.Table, error) {
+			return bigtable.NewTableWithBackend(name, failingBackend{}), nil
+		})
+	if err == nil {
+		t.Errorf("updateImportGroupTables() with failing table got no error")
+	}
+	if diff := cmp.Diff(s.store.BtGroup.TableNames(), want); diff != "" {
+		t.Errorf("TableNames() after failed update got diff %v", diff)
+	}
+	if s.getCache() != cache {
+		t.Errorf("updateImportGroupTables() rebuilt the cache after failed update")
+	}
+
+	// A new table without cache rows fails the probe read.
+	err = s.updateImportGroupTables(ctx,
+		[]string{"frequent_2022_03"},
+		func(name string) (*bigtable.Table, error) {
+			return bigtable.NewTableWithBackend(name, bigtable.NewLocalBackend(nil)), nil
+		})
+	if err == nil {
+		t.Errorf("updateImportGroupTables() with empty table got no error")
+	}
+	if diff := cmp.Diff(s.store.BtGroup.TableNames(), want); diff != "" {
+		t.Errorf("TableNames() after update with empty table got diff %v", diff)
+	}
+
+	// A failed cache rebuild rolls back to the current tables.
+	err = s.updateImportGroupTables(ctx,
+		[]string{"frequent_2022_03"},
+		func(name string) (*bigtable.Table, error) {
+			return bigtable.NewTableWithBackend(name, probeOnlyBackend{}), nil
+		})
+	if err == nil {
+		t.Errorf("updateImportGroupTables() with failed rebuild got no error")
+	}
+	if diff := cmp.Diff(s.store.BtGroup.TableNames(), want); diff != "" {
+		t.Errorf("TableNames() after failed rebuild got diff %v", diff)
+	}
+	if s.getCache() != cache {
+		t.Errorf("updateImportGroupTables() replaced the cache after failed rebuild")
+	}
+}
+
+func TestUpdateImportGroupTablesNameIndex(t *testing.T) {
+	ctx := context.Background()
+	placeTable := func(name, dcid, placeName string) *bigtable.Table {
+		data, err := proto.Marshal(&pb.PlaceMetadataCache{
+			Places: []*pb.PlaceMetadataCache_PlaceInfo{{Dcid: dcid, Name: placeName}},
+		})
+		if err != nil {
+			t.Fatalf("proto.Marshal() = %s", err)
+		}
+		raw, err := util.ZipAndEncode(data)
+		if err != nil {
+			t.Fatalf("util.ZipAndEncode() = %s", err)
+		}
+		return bigtable.NewTableWithBackend(name, bigtable.NewLocalBackend(
+			map[string][]byte{bigtable.BtPlacesMetadataPrefix + dcid: []byte(raw)}))
+	}
+	s := NewReconServer(store.NewStore(nil, nil, []*bigtable.Table{
+		placeTable("frequent_2022_01", "geoId/06", "California"),
+	}, ""), recon.NewNameIndex(nil), nil)
+	resolve := func(name string) []string {
+		dcids := []string{}
+		for _, c := range s.getNameIndex().Resolve(name, nil, nil) {
+			dcids = append(dcids, c.Entry.Dcid)
+		}
+		return dcids
+	}
+
+	err := s.updateImportGroupTables(ctx, []string{"frequent_2022_02"},
+		func(name string) (*bigtable.Table, error) {
+			return placeTable(name, "geoId/08", "Colorado"), nil
+		})
+	if err != nil {
+		t.Fatalf("updateImportGroupTables() = %s", err)
+	}
+	if diff := cmp.Diff(resolve("Colorado"), []string{"geoId/08"}); diff != "" {
+		t.Errorf("Resolve(Colorado) got diff %v", diff)
+	}
+	// The places of the old tables are dropped.
+	if got := resolve("California"); len(got) != 0 {
+		t.Errorf("Resolve(California) = %v after update, want none", got)
+	}
+}
+
+func TestImportGroupInterceptor(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		ImportGroupsKey, "frequent, ipcc",
+		ExcludeImportGroupsKey, "ipcc_2022_01",
+	))
+	got := []string{}
+	tables := []*bigtable.Table{}
+	for _, name := range []string{"frequent_2022_01", "ipcc_2022_01", "infrequent_2022_01"} {
+		tables = append(tables, bigtable.NewTableWithBackend(name, readMarker{&got, name}))
+	}
+	group := bigtable.NewGroup(tables, "")
+	_, err := ImportGroupInterceptor(ctx, nil, &grpc.UnaryServerInfo{},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			_, _, err := bigtable.Read(ctx, group, "d/1/", [][]string{{"key"}},
+				func([]byte) (interface{}, error) { return nil, nil })
+			return nil, err
+		})
+	if err != nil {
+		t.Fatalf("ImportGroupInterceptor() = %s", err)
+	}
+	if diff := cmp.Diff(got, []string{"frequent_2022_01"}); diff != "" {
+		t.Errorf("ImportGroupInterceptor() read tables diff %v", diff)
+	}
+}
+
+// readMarker is a backend that records the reads of a table.
+type readMarker struct {
+	read *[]string
+	name string
+}
+
+func (m readMarker) ReadRows(
+	ctx context.Context, keys []string, fn func(string, []byte) bool,
+) error {
+	*m.read = append(*m.read, m.name)
+	return nil
+}
+
+func (m readMarker) ReadPrefix(
+	ctx context.Context, prefix string, fn func(string, []byte) bool,
+) error {
+	*m.read = append(*m.read, m.name)
+	return nil
+}
+
+func TestWatchRankingConfig(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	defer applyRankingConfig(&ranking.Config{Version: ranking.DefaultVersion}, nil)
+
+	path := filepath.Join(t.TempDir(), "ranking.json")
+	writeConfig := func(config string) {
+		if err := os.WriteFile(path, []byte(config), 0644); err != nil {
+			t.Fatalf("WriteFile() = %s", err)
+		}
+	}
+	writeConfig(`{"version": "1", "importGroupRank": {"ipcc": 0, "frequent": 1}}`)
+
+	group := bigtable.NewGroup([]*bigtable.Table{
+		bigtable.NewTableWithBackend("frequent_2022", nil),
+		bigtable.NewTableWithBackend("ipcc_2022", nil),
+	}, "")
+	if err := WatchRankingConfig(ctx, path, 10*time.Millisecond, group); err != nil {
+		t.Fatalf("WatchRankingConfig() = %s", err)
+	}
+	if diff := cmp.Diff(group.TableNames(), []string{"ipcc_2022", "frequent_2022"}); diff != "" {
+		t.Errorf("TableNames() got diff %v", diff)
+	}
+	s := NewMixerServer(store.NewStore(nil, nil, nil, ""), nil, nil)
+	res, err := s.GetRankingConfig(ctx, &pb.GetRankingConfigRequest{})
+	if err != nil {
+		t.Fatalf("GetRankingConfig() = %s", err)
+	}
+	if res.GetVersion() != "1" || res.GetImportGroupRanks()["ipcc"] != 0 {
+		t.Errorf("GetRankingConfig() = %v, want version 1 with ipcc rank 0", res)
+	}
+	// The groups not in the config keep their default rank.
+	if got, ok := res.GetImportGroupRanks()["dcbranch"]; !ok || got != 0 {
+		t.Errorf("GetRankingConfig() dcbranch rank = %d, want the default 0", got)
+	}
+	if len(res.GetStatsRanking()) != len(ranking.StatsRanking) {
+		t.Errorf("GetRankingConfig() has %d stats ranks, want the default %d",
+			len(res.GetStatsRanking()), len(ranking.StatsRanking))
+	}
+
+	// Invalid configs are not applied.
+	writeConfig(`{"importGroupRank": {"frequent": 0}}`)
+	time.Sleep(50 * time.Millisecond)
+	if got := ranking.CurrentVersion(); got != "1" {
+		t.Errorf("CurrentVersion() = %s after invalid config, want 1", got)
+	}
+
+	writeConfig(`{"version": "2", "importGroupRank": {"ipcc": 1, "frequent": 0}}`)
+	for i := 0; i < 100 && ranking.CurrentVersion() != "2"; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got := ranking.CurrentVersion(); got != "2" {
+		t.Fatalf("CurrentVersion() = %s after reload, want 2", got)
+	}
+	if diff := cmp.Diff(group.TableNames(), []string{"frequent_2022", "ipcc_2022"}); diff != "" {
+		t.Errorf("TableNames() after reload got diff %v", diff)
+	}
+}
@@ -138,7 +138,7 @@ func ReadStats(
 	places []string,
 	statVars []string,
 ) (map[string]map[string]*model.ObsTimeSeries, error) {
-	btDataList, err := bigtable.Read(
+	btDataList, _, err := bigtable.Read(
 		ctx,
 		btGroup,
 		bigtable.BtObsTimeSeries,
@@ -181,7 +181,7 @@ func ReadStatsPb(
 	places []string,
 	statVars []string,
 ) (map[string]map[string]*pb.ObsTimeSeries, error) {
-	btDataList, err := bigtable.Read(
+	btDataList, _, err := bigtable.Read(
 		ctx,
 		btGroup,
 		bigtable.BtObsTimeSeries,
@@ -217,7 +217,7 @@ func ReadStatsPb(
 	// collect the source with the most (latest) data.
 	for p := range result {
 		for sv := range result[p] {
-			result[p][sv].SourceSeries = CollectDistinctSourceSeries(result[p][sv].SourceSeries)
+			result[p][sv].SourceSeries = CollectDistinctSourceSeries(sv, result[p][sv].SourceSeries)
 		}
 	}
 	return result, nil
@@ -234,7 +234,7 @@ func ReadStatCollection(
 	statVars []string,
 	date string,
 ) (map[string]*pb.ObsCollection, error) {
-	btDataList, err := bigtable.Read(
+	btDataList, _, err := bigtable.Read(
 		ctx,
 		btGroup,
 		prefix,
@@ -262,7 +262,7 @@ func ReadStatCollection(
 	}
 	for sv := range result {
 		if len(result[sv].SourceCohorts) > 0 {
-			result[sv].SourceCohorts = CollectDistinctSourceSeries(result[sv].SourceCohorts)
+			result[sv].SourceCohorts = CollectDistinctSourceSeries(sv, result[sv].SourceCohorts)
 		} else {
 			result[sv] = nil
 		}
@@ -19,7 +19,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"sort"
 	"time"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
@@ -66,7 +65,7 @@ func GetStatSeries(
 	}
 	series := btData[place][statVar].SourceSeries
 	series = FilterSeries(series, filterProp)
-	sort.Sort(ranking.ByRank(series))
+	ranking.SortByRank(statVar, series)
 	resp := pb.GetStatSeriesResponse{Series: map[string]float64{}}
 	if len(series) > 0 {
 		resp.Series = series[0].Val
@@ -107,7 +106,7 @@ func GetStatAll(ctx context.Context, in *pb.GetStatAllRequest, store *store.Stor
 	for place, placeData := range cacheData {
 		for statVar, data := range placeData {
 			if data != nil && data.SourceSeries != nil {
-				sort.Sort(ranking.SeriesByRank(data.SourceSeries))
+				ranking.SortSeries(statVar, data.SourceSeries)
 			}
 			result.PlaceData[place].StatVarData[statVar] = data
 		}
@@ -160,7 +159,7 @@ func GetStats(ctx context.Context, in *pb.GetStatsRequest, store *store.Store) (
 	result := map[string]*model.GetStatsResponse{}
 	for place, obsSeries := range tmp {
 		if obsSeries != nil {
-			FilterAndRank(obsSeries, filterProp)
+			FilterAndRank(obsSeries, statsVarDcid, filterProp)
 			result[place] = &model.GetStatsResponse{
 				PlaceName: obsSeries.PlaceName,
 			}
@@ -215,7 +214,7 @@ func GetStatSetSeries(ctx context.Context, in *pb.GetStatSetSeriesRequest, store
 		for place, placeData := range cacheData {
 			for statVar, data := range placeData {
 				if data != nil {
-					series, _ := GetBestSeries(data, importName, false /* useLatest */)
+					series, _ := GetBestSeries(data, statVar, importName, false /* useLatest */)
 					result.Data[place].Data[statVar] = series
 				}
 			}
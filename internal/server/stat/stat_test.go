@@ -18,6 +18,7 @@ import (
 	"sort"
 	"testing"
 
+	pb "github.com/datacommonsorg/mixer/internal/proto"
 	"github.com/datacommonsorg/mixer/internal/server/model"
 	"github.com/datacommonsorg/mixer/internal/server/ranking"
 	"github.com/google/go-cmp/cmp"
@@ -166,7 +167,7 @@ func TestFilterAndRank(t *testing.T) {
 		},
 	} {
 		got := c.input
-		FilterAndRank(got, &model.StatObsProp{
+		FilterAndRank(got, "Count_Person", &model.StatObsProp{
 			MeasurementMethod: c.mmethod,
 			ObservationPeriod: c.op,
 			Unit:              c.unit,
@@ -248,9 +249,43 @@ func TestGetLatest(t *testing.T) {
 			200,
 		},
 	} {
-		value, _ := GetValueFromBestSource(obsTimeSeries, c.date)
+		value, _ := GetValueFromBestSource(obsTimeSeries, "Count_Person", c.date)
 		if c.want != value {
 			t.Errorf("Wrong latest value %f", value)
 		}
 	}
 }
+
+func TestIsInferiorFacetOfStatVar(t *testing.T) {
+	defer ranking.ApplyConfig(&ranking.Config{Version: ranking.DefaultVersion})
+	c, err := ranking.ParseConfig([]byte(`{
+		"version": "1",
+		"statsRanking": [
+			{"statVar": "Count_Person_Urban", "importName": "WikipediaStatsData",
+			 "measurementMethod": "*", "observationPeriod": "*", "rank": 0}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("ParseConfig() = %s", err)
+	}
+	ranking.ApplyConfig(c)
+
+	series := &pb.SourceSeries{ImportName: "WikipediaStatsData", MeasurementMethod: "Wikipedia"}
+	metadata := &pb.StatMetadata{ImportName: "WikipediaStatsData", MeasurementMethod: "Wikipedia"}
+	for statVar, want := range map[string]bool{
+		"Count_Person":       true,
+		"Count_Person_Urban": false,
+	} {
+		if got := IsInferiorFacetPb(statVar, series); got != want {
+			t.Errorf("IsInferiorFacetPb(%s) = %t, want %t", statVar, got, want)
+		}
+		if got := IsInferiorFacetMetadata(statVar, metadata); got != want {
+			t.Errorf("IsInferiorFacetMetadata(%s) = %t, want %t", statVar, got, want)
+		}
+		if got := IsInferiorFacet(statVar, &model.SourceSeries{
+			ImportName: "WikipediaStatsData", MeasurementMethod: "Wikipedia",
+		}); got != want {
+			t.Errorf("IsInferiorFacet(%s) = %t, want %t", statVar, got, want)
+		}
+	}
+}
@@ -15,8 +15,6 @@
 package stat
 
 import (
-	"sort"
-
 	pb "github.com/datacommonsorg/mixer/internal/proto"
 	"github.com/datacommonsorg/mixer/internal/server/model"
 	"github.com/datacommonsorg/mixer/internal/server/ranking"
@@ -27,22 +25,22 @@ import (
 
 const inferiorFacetThreshold = 1000
 
-// IsInferiorFacetPb checks if a facet is from an inferior source.
+// IsInferiorFacetPb checks if a facet of a stat var is from an inferior source.
 // This works for the proto version of "SourceSeries"
-func IsInferiorFacetPb(ss *pb.SourceSeries) bool {
-	return ranking.GetScorePb(ss) > inferiorFacetThreshold
+func IsInferiorFacetPb(statVar string, ss *pb.SourceSeries) bool {
+	return ranking.GetStatVarScorePb(statVar, ss) > inferiorFacetThreshold
 }
 
-// IsInferiorFacetMetadata checks if a facet is from an inferior source.
-// This works for StatMetadata
-func IsInferiorFacetMetadata(m *pb.StatMetadata) bool {
-	return ranking.GetMetadataScore(m) > inferiorFacetThreshold
+// IsInferiorFacetMetadata checks if a facet of a stat var is from an inferior
+// source. This works for StatMetadata
+func IsInferiorFacetMetadata(statVar string, m *pb.StatMetadata) bool {
+	return ranking.GetStatVarMetadataScore(statVar, m) > inferiorFacetThreshold
 }
 
-// IsInferiorFacet checks if a facet is from an inferior source.
+// IsInferiorFacet checks if a facet of a stat var is from an inferior source.
 // This works for the Go version of "SourceSeries"
-func IsInferiorFacet(ss *model.SourceSeries) bool {
-	return ranking.GetScore(ss) > inferiorFacetThreshold
+func IsInferiorFacet(statVar string, ss *model.SourceSeries) bool {
+	return ranking.GetStatVarScore(statVar, ss) > inferiorFacetThreshold
 }
 
 // FilterSeries filters a list of source series given the observation properties.
@@ -66,17 +64,18 @@ func FilterSeries(in []*model.SourceSeries, prop *model.StatObsProp) []*model.So
 	return result
 }
 
-// FilterAndRank filters and ranks ObsTimeSeries in place.
-func FilterAndRank(in *model.ObsTimeSeries, prop *model.StatObsProp) {
+// FilterAndRank filters and ranks ObsTimeSeries of a stat var in place.
+func FilterAndRank(in *model.ObsTimeSeries, statVar string, prop *model.StatObsProp) {
 	if in == nil {
 		return
 	}
 	series := FilterSeries(in.SourceSeries, prop)
-	sort.Sort(ranking.ByRank(series))
+	ranking.SortByRank(statVar, series)
 	in.SourceSeries = series
 }
 
-// GetBestSeries gets the best series for a collection of series with different metadata.
+// GetBestSeries gets the best series for a collection of series of a stat var
+// with different metadata.
 //
 // - If "importName" is set, pick the series with the import name.
 // - If "useLatest" is true, pick the series with latest date and set the
@@ -85,6 +84,7 @@ func FilterAndRank(in *model.ObsTimeSeries, prop *model.StatObsProp) {
 // Note "importName" is preferred over "useLatest".
 func GetBestSeries(
 	in *pb.ObsTimeSeries,
+	statVar string,
 	importName string,
 	useLatest bool,
 ) (*pb.Series, *string) {
@@ -98,7 +98,7 @@ func GetBestSeries(
 		}
 		return nil, nil
 	}
-	sort.Sort(ranking.SeriesByRank(rawSeries))
+	ranking.SortSeries(statVar, rawSeries)
 	if len(rawSeries) > 0 {
 		// Choose the latest series.
 		if useLatest {
@@ -130,19 +130,21 @@ func rawSeriesToSeries(raw *pb.SourceSeries) *pb.Series {
 	return result
 }
 
-// GetValueFromBestSource get the stat value from top ranked source series.
+// GetValueFromBestSource get the stat value of a stat var from top ranked
+// source series.
 //
 // When date is given, it get the value from the highest ranked source series
 // that has the date.
 //
 // When date is not given, it get the latest value from the highest ranked
 // source series.
-func GetValueFromBestSource(in *model.ObsTimeSeries, date string) (float64, error) {
+func GetValueFromBestSource(
+	in *model.ObsTimeSeries, statVar, date string) (float64, error) {
 	if in == nil {
 		return 0, status.Error(codes.Internal, "Nil obs time series for getValueFromBestSource()")
 	}
 	sourceSeries := in.SourceSeries
-	sort.Sort(ranking.ByRank(sourceSeries))
+	ranking.SortByRank(statVar, sourceSeries)
 	if date != "" {
 		for _, series := range sourceSeries {
 			if value, ok := series.Val[date]; ok {
@@ -154,7 +156,7 @@ func GetValueFromBestSource(in *model.ObsTimeSeries, date string) (float64, erro
 	latestDate := ""
 	var result float64
 	for idx, series := range sourceSeries {
-		if idx > 0 && IsInferiorFacet(series) {
+		if idx > 0 && IsInferiorFacet(statVar, series) {
 			break
 		}
 		for date, value := range series.Val {
@@ -171,7 +173,8 @@ func GetValueFromBestSource(in *model.ObsTimeSeries, date string) (float64, erro
 	return result, nil
 }
 
-// GetValueFromBestSourcePb get the stat value from ObsTimeSeries (protobuf version)
+// GetValueFromBestSourcePb get the stat value of a stat var from ObsTimeSeries
+// (protobuf version)
 //
 // When date is given, it get the value from the highest ranked source series
 // that has the date.
@@ -179,12 +182,12 @@ func GetValueFromBestSource(in *model.ObsTimeSeries, date string) (float64, erro
 // When date is not given, it get the latest value from all the source series.
 // If two sources has the same latest date, the highest ranked source is preferred.
 func GetValueFromBestSourcePb(
-	in *pb.ObsTimeSeries, date string) (*pb.PointStat, *pb.StatMetadata) {
+	in *pb.ObsTimeSeries, statVar, date string) (*pb.PointStat, *pb.StatMetadata) {
 	if in == nil {
 		return nil, nil
 	}
 	sourceSeries := in.SourceSeries
-	sort.Sort(ranking.SeriesByRank(sourceSeries))
+	ranking.SortSeries(statVar, sourceSeries)
 
 	// Date is given, get the value from highest ranked source that has this date.
 	if date != "" {
@@ -203,12 +206,12 @@ func GetValueFromBestSourcePb(
 	latestDate := ""
 	var ps *pb.PointStat
 	var meta *pb.StatMetadata
-	// At this stage, sourceSeries has import series ranked by the ranking score.
-	// (accomplished by SeriesByRank function above)
+	// At this stage, sourceSeries has import series ranked by the ranking rules
+	// of the stat var (accomplished by ranking.SortSeries above).
 	for idx, series := range sourceSeries {
 		// If there is higher quality facet, then do not pick from the inferior
 		//facet even it could have more recent data.
-		if idx > 0 && IsInferiorFacetPb(series) {
+		if idx > 0 && IsInferiorFacetPb(statVar, series) {
 			break
 		}
 		for date, value := range series.Val {
@@ -245,10 +248,12 @@ func getSourceSeriesHash(series *pb.SourceSeries) uint32 {
 	return util.GetMetadataHash(GetMetadata(series))
 }
 
-// CollectDistinctSourceSeries merges lists of SourceSeries.
+// CollectDistinctSourceSeries merges lists of SourceSeries of a stat var.
 // For same source series, the one with more data points is used. In most cases,
 // this is the series with the latest data as well.
-func CollectDistinctSourceSeries(seriesList ...[]*pb.SourceSeries) []*pb.SourceSeries {
+func CollectDistinctSourceSeries(
+	statVar string, seriesList ...[]*pb.SourceSeries,
+) []*pb.SourceSeries {
 	result := []*pb.SourceSeries{}
 	resultMap := map[uint32]*pb.SourceSeries{}
 	for _, series := range seriesList {
@@ -263,6 +268,6 @@ func CollectDistinctSourceSeries(seriesList ...[]*pb.SourceSeries) []*pb.SourceS
 			result = append(result, s)
 		}
 	}
-	sort.Sort(ranking.SeriesByRank(result))
+	ranking.SortSeries(statVar, result)
 	return result
 }
@@ -34,7 +34,7 @@ func Count(
 	svOrSvgs []string,
 	places []string,
 ) (map[string]map[string]int32, error) {
-	btDataList, err := bigtable.Read(
+	btDataList, _, err := bigtable.Read(
 		ctx,
 		st.BtGroup,
 		bigtable.BtSVAndSVGExistence,
@@ -55,7 +55,7 @@ func GetPlaceStatsVar(
 func GetEntityStatVarsHelper(
 	ctx context.Context, entities []string, store *store.Store) (
 	map[string]*pb.StatVars, error) {
-	btDataList, err := bigtable.Read(
+	btDataList, _, err := bigtable.Read(
 		ctx,
 		store.BtGroup,
 		bigtable.BtPlaceStatsVarPrefix,
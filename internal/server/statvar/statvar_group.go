@@ -139,7 +139,7 @@ func GetStatVarGroup(
 		// Read stat var group cache from the frequent import group table. It has
 		// the latest and trustworthy stat var schemas and no need to merge with
 		// other import groups.
-		btDataList, err := bigtable.Read(
+		btDataList, _, err := bigtable.Read(
 			ctx,
 			bigtable.GetFrequentGroup(store.BtGroup),
 			bigtable.BtStatVarGroup,
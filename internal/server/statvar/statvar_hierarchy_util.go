@@ -41,7 +41,7 @@ func GetRawSvg(ctx context.Context, store *store.Store) (
 	if err != nil {
 		return nil, err
 	}
-	return svg.StatVarGroups, nil
+	return svg.GetStatVarGroups(), nil
 }
 
 // BuildParentSvgMap gets the mapping of svg/sv id to the parent svg for that
@@ -29,7 +29,7 @@ import (
 func GetStatVarSummaryHelper(
 	ctx context.Context, entities []string, store *store.Store) (
 	map[string]*pb.StatVarSummary, error) {
-	btDataList, err := bigtable.Read(
+	btDataList, _, err := bigtable.Read(
 		ctx,
 		store.BtGroup,
 		bigtable.BtStatVarSummary,
@@ -80,5 +80,27 @@ func GetStatVarSummaryHelper(
 			}
 		}
 	}
+	// Add the provenances of the private import in memdb.
+	if store.MemDb != nil {
+		for _, entity := range entities {
+			svs := store.MemDb.ReadStatVarSummary(entity)
+			if svs == nil {
+				continue
+			}
+			res, ok := result[entity]
+			if !ok {
+				result[entity] = svs
+				continue
+			}
+			if res.ProvenanceSummary == nil {
+				res.ProvenanceSummary = map[string]*pb.StatVarSummary_ProvenanceSummary{}
+			}
+			for source, summary := range svs.ProvenanceSummary {
+				if _, ok := res.ProvenanceSummary[source]; !ok {
+					res.ProvenanceSummary[source] = summary
+				}
+			}
+		}
+	}
 	return result, nil
 }
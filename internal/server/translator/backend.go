@@ -0,0 +1,117 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translator
+
+import (
+	"context"
+	"database/sql"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"github.com/datacommonsorg/mixer/internal/store/sqldb"
+
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Backend runs translated SQL queries.
+type Backend interface {
+	// Query runs the SQL query and calls fn with the cells of each result row.
+	Query(ctx context.Context, sql string, fn func(row []interface{}) error) error
+}
+
+// NewBackend gets the query backend of the store. The local SQL database is
+// used when it is loaded, otherwise BigQuery.
+func NewBackend(store *store.Store) (Backend, error) {
+	if store.SQLDb != nil {
+		return &sqlBackend{db: store.SQLDb}, nil
+	}
+	if store.BqClient != nil {
+		return &bigQueryBackend{client: store.BqClient}, nil
+	}
+	return nil, status.Errorf(codes.FailedPrecondition, "no backend to run query")
+}
+
+type bigQueryBackend struct {
+	client *bigquery.Client
+}
+
+func (b *bigQueryBackend) Query(
+	ctx context.Context,
+	sql string,
+	fn func(row []interface{}) error,
+) error {
+	it, err := b.client.Query(sql).Read(ctx)
+	if err != nil {
+		return err
+	}
+	for {
+		var row []bigquery.Value
+		err := it.Next(&row)
+		if err == iterator.Done {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		cells := make([]interface{}, len(row))
+		for i, cell := range row {
+			cells[i] = cell
+		}
+		if err := fn(cells); err != nil {
+			return err
+		}
+	}
+}
+
+type sqlBackend struct {
+	db *sql.DB
+}
+
+func (b *sqlBackend) Query(
+	ctx context.Context,
+	query string,
+	fn func(row []interface{}) error,
+) error {
+	rows, err := b.db.QueryContext(ctx, sqldb.ToSQLite(query))
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to run query: %v", err)
+	}
+	defer rows.Close()
+	cols, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		cells := make([]interface{}, len(cols))
+		ptrs := make([]interface{}, len(cols))
+		for i := range cells {
+			ptrs[i] = &cells[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return err
+		}
+		for i, cell := range cells {
+			if b, ok := cell.([]byte); ok {
+				cells[i] = string(b)
+			}
+		}
+		if err := fn(cells); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
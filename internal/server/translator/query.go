@@ -16,17 +16,22 @@ package translator
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"strings"
 
-	"cloud.google.com/go/bigquery"
+	"github.com/datacommonsorg/mixer/internal/server/pagination"
 	"github.com/datacommonsorg/mixer/internal/server/resource"
 	"github.com/datacommonsorg/mixer/internal/store"
 	"github.com/datacommonsorg/mixer/internal/translator"
 	"github.com/datacommonsorg/mixer/internal/translator/sparql"
+	"github.com/datacommonsorg/mixer/internal/util"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
 
-	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Query implements API for Mixer.Query.
@@ -40,6 +45,36 @@ func Query(
 	if err != nil {
 		return nil, err
 	}
+	pageSize := int(in.GetLimit())
+	if pageSize < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid limit %d", pageSize)
+	}
+	// Position of the first row of this page in the query result.
+	start := 0
+	if token := in.GetNextToken(); token != "" {
+		if pageSize == 0 {
+			return nil, status.Errorf(
+				codes.InvalidArgument, "limit is required to use next_token")
+		}
+		start, err = decodeQueryToken(token, in.GetSparql())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if pageSize > 0 {
+		// Read one more row to know if there is a next page.
+		fetch := pageSize + 1
+		if opts.Limit > 0 {
+			if remaining := opts.Limit - start; remaining < fetch {
+				fetch = remaining
+			}
+			if fetch <= 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid next_token")
+			}
+		}
+		opts.Offset += start
+		opts.Limit = fetch
+	}
 
 	translation, err := translator.Translate(
 		metadata.Mappings, nodes, queries, metadata.SubTypeMap, opts)
@@ -54,21 +89,12 @@ func Query(
 	out.Rows = []*pb.QueryResponseRow{}
 	n := len(out.Header)
 
-	q := store.BqClient.Query(translation.SQL)
-	it, err := q.Read(ctx)
+	backend, err := NewBackend(store)
 	if err != nil {
 		return nil, err
 	}
-	for {
+	err = backend.Query(ctx, translation.SQL, func(row []interface{}) error {
 		responseRow := pb.QueryResponseRow{}
-		var row []bigquery.Value
-		err := it.Next(&row)
-		if err == iterator.Done {
-			break
-		}
-		if err != nil {
-			return nil, err
-		}
 		for i, cell := range row {
 			var str string
 			if cell != nil {
@@ -95,6 +121,59 @@ func Query(
 			}
 		}
 		out.Rows = append(out.Rows, &responseRow)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if pageSize > 0 && len(out.Rows) > pageSize {
+		out.Rows = out.Rows[:pageSize]
+		out.NextToken, err = encodeQueryToken(in.GetSparql(), start+pageSize)
+		if err != nil {
+			return nil, err
+		}
 	}
 	return &out, nil
 }
+
+// queryHash gets the hash of a sparql query with normalized white spaces, so
+// tokens do not grow with or expose the query.
+func queryHash(sparql string) string {
+	sum := sha256.Sum256([]byte(strings.Join(strings.Fields(sparql), " ")))
+	return hex.EncodeToString(sum[:])
+}
+
+// encodeQueryToken encodes the position of the next row of a query result. The
+// query hash is kept as the cursor group key to validate the token.
+func encodeQueryToken(sparql string, next int) (string, error) {
+	return util.EncodeProto(&pb.PaginationInfo{
+		CursorGroups: []*pb.CursorGroup{
+			{
+				Keys:    []string{queryHash(sparql)},
+				Cursors: []*pb.Cursor{{Item: int32(next)}},
+			},
+		},
+	})
+}
+
+// decodeQueryToken decodes the position of the next row from a token created
+// by encodeQueryToken for the same query.
+func decodeQueryToken(token, sparql string) (int, error) {
+	info, err := pagination.Decode(token)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid next_token: %s", err)
+	}
+	groups := info.GetCursorGroups()
+	if len(groups) != 1 || len(groups[0].GetCursors()) != 1 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid next_token")
+	}
+	if keys := groups[0].GetKeys(); len(keys) != 1 || keys[0] != queryHash(sparql) {
+		return 0, status.Errorf(
+			codes.InvalidArgument, "next_token does not match the sparql query")
+	}
+	item := groups[0].GetCursors()[0].GetItem()
+	if item < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid next_token")
+	}
+	return int(item), nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translator
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/pagination"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestQueryToken(t *testing.T) {
+	sparql := "SELECT ?a WHERE { ?a typeOf State } ORDER BY ?a"
+	token, err := encodeQueryToken(sparql, 20)
+	if err != nil {
+		t.Fatalf("encodeQueryToken() = %s", err)
+	}
+	got, err := decodeQueryToken(token, sparql)
+	if err != nil {
+		t.Fatalf("decodeQueryToken() = %s", err)
+	}
+	if got != 20 {
+		t.Errorf("decodeQueryToken() = %d, want 20", got)
+	}
+	if _, err := decodeQueryToken(token, "SELECT ?b WHERE { ?b typeOf State }"); err == nil {
+		t.Errorf("decodeQueryToken() with another query = nil, want error")
+	}
+	if _, err := decodeQueryToken("abc", sparql); err == nil {
+		t.Errorf("decodeQueryToken() with invalid token = nil, want error")
+	}
+	negative, err := encodeQueryToken(sparql, -5)
+	if err != nil {
+		t.Fatalf("encodeQueryToken() = %s", err)
+	}
+	if _, err := decodeQueryToken(negative, sparql); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("decodeQueryToken() with negative item = %v, want InvalidArgument", err)
+	}
+	// White spaces of the query do not matter.
+	if _, err := decodeQueryToken(token, "SELECT ?a\nWHERE {\n  ?a typeOf State\n}\nORDER BY ?a"); err != nil {
+		t.Errorf("decodeQueryToken() with reformatted query = %s", err)
+	}
+	info, err := pagination.Decode(token)
+	if err != nil {
+		t.Fatalf("pagination.Decode() = %s", err)
+	}
+	for _, key := range info.GetCursorGroups()[0].GetKeys() {
+		if strings.Contains(key, "typeOf") {
+			t.Errorf("token key %s contains the query", key)
+		}
+	}
+}
+
+func TestQueryTokenOfAnotherQuery(t *testing.T) {
+	token, err := encodeQueryToken("SELECT ?a WHERE { ?a typeOf State } ORDER BY ?a", 20)
+	if err != nil {
+		t.Fatalf("encodeQueryToken() = %s", err)
+	}
+	_, err = Query(context.Background(), &pb.QueryRequest{
+		Sparql:    "SELECT ?a WHERE { ?a typeOf Country } ORDER BY ?a",
+		Limit:     10,
+		NextToken: token,
+	}, nil, nil)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Query() with token of another query = %v, want InvalidArgument", err)
+	}
+}
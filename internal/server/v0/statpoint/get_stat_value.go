@@ -58,7 +58,7 @@ func GetStatValue(ctx context.Context, in *pb.GetStatValueRequest, store *store.
 		return result, nil
 	}
 	obsTimeSeries.SourceSeries = stat.FilterSeries(obsTimeSeries.SourceSeries, filterProp)
-	value, err := stat.GetValueFromBestSource(obsTimeSeries, date)
+	value, err := stat.GetValueFromBestSource(obsTimeSeries, statVar, date)
 	if err != nil {
 		return result, nil
 	}
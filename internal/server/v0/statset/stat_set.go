@@ -62,7 +62,7 @@ func getStatSet(
 			if !ok || data == nil {
 				continue
 			}
-			stat, metaData := stat.GetValueFromBestSourcePb(data, date)
+			stat, metaData := stat.GetValueFromBestSourcePb(data, statVar, date)
 			if stat == nil {
 				continue
 			}
@@ -253,7 +253,7 @@ func GetStatSetWithinPlace(
 		gotResult = true
 		cohorts := data.SourceCohorts
 		// Sort cohort first, so the preferred source is populated first.
-		sort.Sort(ranking.CohortByRank(cohorts))
+		ranking.SortCohorts(statVar, cohorts)
 		for _, cohort := range cohorts {
 			metaData := stat.GetMetadata(cohort)
 			metaHash := util.GetMetadataHash(metaData)
@@ -271,7 +271,7 @@ func GetStatSetWithinPlace(
 				// When observation exists from higher ranked cohort, but the current
 				// cohort has later date and is not inferior facet (like wikidata),
 				// prefer the current cohort.
-				shouldResetValue := exist && respDate > pointStat.Date && !stat.IsInferiorFacetPb(cohort)
+				shouldResetValue := exist && respDate > pointStat.Date && !stat.IsInferiorFacetPb(statVar, cohort)
 				if shouldSetValue || shouldResetValue {
 					result.Data[statVar].Stat[place] = &pb.PointStat{
 						Date:     respDate,
@@ -313,7 +313,7 @@ func GetStatSetWithinPlace(
 	if statVarInMemDb {
 		for _, statVar := range statVars {
 			for _, place := range childPlaces {
-				pointValue, metaData := store.MemDb.ReadPointValue(statVar, place, date)
+				pointValue, metaData := store.MemDb.ReadPointValue(statVar, place, date, pb.DateMatch_DATE_MATCH_EXACT)
 				// Override public data from private import
 				if pointValue != nil {
 					metaHash := util.GetMetadataHash(metaData)
@@ -388,7 +388,7 @@ func GetStatSetWithinPlaceAll(
 			continue
 		}
 		gotResult = true
-		sort.Sort(ranking.CohortByRank(data.SourceCohorts))
+		ranking.SortCohorts(statVar, data.SourceCohorts)
 		for _, cohort := range data.SourceCohorts {
 			// The cohort is from the same source.
 			metaData := stat.GetMetadata(cohort)
@@ -444,7 +444,7 @@ func GetStatSetWithinPlaceAll(
 				Stat: make(map[string]*pb.PointStat),
 			}
 			for i, place := range childPlaces {
-				pointValue, metaData := store.MemDb.ReadPointValue(statVar, place, date)
+				pointValue, metaData := store.MemDb.ReadPointValue(statVar, place, date, pb.DateMatch_DATE_MATCH_EXACT)
 				var metaHash uint32
 				if pointValue != nil {
 					if i == 0 {
@@ -18,13 +18,15 @@ package observations
 
 import (
 	"context"
-	"sort"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
 	"github.com/datacommonsorg/mixer/internal/server/ranking"
 	"github.com/datacommonsorg/mixer/internal/server/stat"
 	"github.com/datacommonsorg/mixer/internal/store"
+	"github.com/datacommonsorg/mixer/internal/store/memdb"
 	"github.com/datacommonsorg/mixer/internal/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // BulkPoint implements API for Mixer.BulkObservationsPoint.
@@ -36,7 +38,25 @@ func BulkPoint(
 	entities := in.GetEntities()
 	variables := in.GetVariables()
 	date := in.GetDate()
+	dateMatch := in.GetDateMatch()
+	facetFilter := in.GetFacetFilter()
 	allFacets := in.GetAllFacets()
+	filter, err := newDateFilter(in.GetStartDate(), in.GetEndDate(), in.GetDates(), 0)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateDerivedVariables(
+		in.GetVariables(), in.GetDerivedVariables(), in.GetTargetUnit()); err != nil {
+		return nil, err
+	}
+	if date != "" && !filter.isEmpty() {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"date can not be used with start_date, end_date or dates")
+	}
+	if date == "" && dateMatch != pb.DateMatch_DATE_MATCH_EXACT {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"date_match can only be used with date")
+	}
 
 	cacheData, err := stat.ReadStatsPb(ctx, store.BtGroup, entities, variables)
 	if err != nil {
@@ -49,7 +69,7 @@ func BulkPoint(
 	tmpResult := map[string]*pb.VariableObservations{}
 	for _, entity := range entities {
 		for _, variable := range variables {
-			series := cacheData[entity][variable].SourceSeries
+			series := filterSourceSeries(facetFilter, cacheData[entity][variable].GetSourceSeries())
 			entityObservations := &pb.EntityObservations{
 				Entity: entity,
 			}
@@ -58,8 +78,29 @@ func BulkPoint(
 					Variable: variable,
 				}
 			}
-			if len(series) > 0 {
-				sort.Sort(ranking.SeriesByRank(series))
+			if len(series) > 0 && date != "" && !allFacets {
+				ranking.SortSeries(variable, series)
+				// Pick the closest date across facets, with the facet rank only
+				// breaking ties, as memdb.MatchPointValue does.
+				vals := make([]map[string]float64, len(series))
+				for i, series := range series {
+					vals[i] = series.Val
+				}
+				if idx, matchedDate := util.MatchDateAcrossSeries(
+					vals, date, dateMatch); idx >= 0 {
+					metadata := stat.GetMetadata(series[idx])
+					facet := util.GetMetadataHash(metadata)
+					entityObservations.PointsByFacet = append(
+						entityObservations.PointsByFacet,
+						&pb.PointStat{
+							Date:  matchedDate,
+							Value: series[idx].Val[matchedDate],
+							Facet: facet,
+						})
+					result.Facets[facet] = metadata
+				}
+			} else if len(series) > 0 {
+				ranking.SortSeries(variable, series)
 				// When date is not given, tract the latest date from each series
 				latestDateAcrossSeries := ""
 				for idx, series := range series {
@@ -67,41 +108,38 @@ func BulkPoint(
 					facet := util.GetMetadataHash(metadata)
 					// Date is given
 					if date != "" {
-						if value, ok := series.Val[date]; ok {
-							ps := &pb.PointStat{
-								Date:  date,
-								Value: value,
-								Facet: facet,
-							}
-							entityObservations.PointsByFacet = append(
-								entityObservations.PointsByFacet, ps)
+						matchedDate := util.MatchDate(series.Val, date, dateMatch)
+						if matchedDate == "" {
+							// No data for the date in this facet.
+							continue
 						}
-						result.Facets[facet] = metadata
-						if !allFacets {
-							break
+						ps := &pb.PointStat{
+							Date:  matchedDate,
+							Value: series.Val[matchedDate],
+							Facet: facet,
 						}
+						entityObservations.PointsByFacet = append(
+							entityObservations.PointsByFacet, ps)
 					} else {
 						// This is to query from one facet and there is already data from
 						// higher ranked facet. If the current facet is from an inferior
 						// facet (like wikidata) then don't use it.
 						// Such inferior facet is only used when there is no better facet
 						// is prsent.
-						if !allFacets && idx > 0 && stat.IsInferiorFacetPb(series) {
+						if !allFacets && idx > 0 && stat.IsInferiorFacetPb(variable, series) {
 							break
 						}
-						var ps *pb.PointStat
-						latestDate := ""
-						for date, value := range series.Val {
-							if date > latestDate {
-								latestDate = date
-								ps = &pb.PointStat{
-									Date:  date,
-									Value: value,
-									Facet: facet,
-								}
-							}
+						latestDate := filter.latest(series.Val)
+						if latestDate == "" {
+							// No data for the dates.
+							continue
 						}
-						if idx == 0 || allFacets {
+						ps := &pb.PointStat{
+							Date:  latestDate,
+							Value: series.Val[latestDate],
+							Facet: facet,
+						}
+						if len(entityObservations.PointsByFacet) == 0 || allFacets {
 							entityObservations.PointsByFacet = append(
 								entityObservations.PointsByFacet, ps)
 						} else if latestDate > latestDateAcrossSeries {
@@ -112,7 +150,8 @@ func BulkPoint(
 					result.Facets[facet] = metadata
 				}
 			} else if store.MemDb.HasStatVar(variable) {
-				pointValue, facet := store.MemDb.ReadPointValue(variable, entity, date)
+				pointValue, facet := readMemDbPoint(
+					store.MemDb, variable, entity, date, dateMatch, filter, facetFilter)
 				if pointValue != nil {
 					facetID := util.GetMetadataHash(facet)
 					pointValue.Facet = facetID
@@ -131,5 +170,45 @@ func BulkPoint(
 		result.ObservationsByVariable = append(
 			result.ObservationsByVariable, tmpResult[variable])
 	}
+	if len(in.GetDerivedVariables()) > 0 {
+		if err := addDerivedPoints(ctx, in, store, result); err != nil {
+			return nil, err
+		}
+	}
+	if targetUnit := in.GetTargetUnit(); targetUnit != "" {
+		if err := convertPointResponse(result, targetUnit); err != nil {
+			return nil, err
+		}
+	}
 	return result, nil
 }
+
+// readMemDbPoint reads the observation point of an entity and a variable from
+// the in-memory database, with the latest date that matches the date filter
+// when date is not given.
+func readMemDbPoint(
+	memDb *memdb.MemDb,
+	variable, entity, date string,
+	dateMatch pb.DateMatch,
+	filter *dateFilter,
+	facetFilter *pb.FacetFilter,
+) (*pb.PointStat, *pb.StatMetadata) {
+	if filter.isEmpty() && isEmptyFacetFilter(facetFilter) {
+		return memDb.ReadPointValue(variable, entity, date, dateMatch)
+	}
+	seriesList := filterMemDbSeries(facetFilter, memDb.ReadSeries(variable, entity))
+	if date != "" {
+		return memdb.MatchPointValue(seriesList, date, dateMatch)
+	}
+	var pointValue *pb.PointStat
+	var facet *pb.StatMetadata
+	for _, series := range seriesList {
+		// Get the latest date for the filter from all series
+		latestDate := filter.latest(series.Val)
+		if latestDate != "" && (pointValue == nil || latestDate > pointValue.Date) {
+			pointValue = &pb.PointStat{Date: latestDate, Value: series.Val[latestDate]}
+			facet = series.Metadata
+		}
+	}
+	return pointValue, facet
+}
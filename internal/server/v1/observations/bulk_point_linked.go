@@ -43,6 +43,7 @@ func BulkPointLinked(
 	variables := in.GetVariables()
 	date := in.GetDate()
 	allFacets := in.GetAllFacets()
+	facetFilter := in.GetFacetFilter()
 	if linkedEntity == "" {
 		return nil, status.Errorf(codes.InvalidArgument,
 			"missing required argument: linked_entity")
@@ -86,9 +87,9 @@ func BulkPointLinked(
 		}
 		gotResult = true
 		entityResult := map[string]*pb.EntityObservations{}
-		cohorts := data.SourceCohorts
+		cohorts := filterSourceSeries(facetFilter, data.SourceCohorts)
 		// Sort cohort first, so the preferred source is populated first.
-		sort.Sort(ranking.CohortByRank(cohorts))
+		ranking.SortCohorts(variable, cohorts)
 		for _, cohort := range cohorts {
 			facet := stat.GetMetadata(cohort)
 			facetID := util.GetMetadataHash(facet)
@@ -160,9 +161,10 @@ func BulkPointLinked(
 		result, err = BulkPoint(
 			ctx,
 			&pb.BulkObservationsPointRequest{
-				Variables: variables,
-				Entities:  childPlaces,
-				Date:      date,
+				Variables:   variables,
+				Entities:    childPlaces,
+				Date:        date,
+				FacetFilter: facetFilter,
 			},
 			store,
 		)
@@ -178,7 +180,9 @@ func BulkPointLinked(
 			}
 			observationsByEntity := []*pb.EntityObservations{}
 			for _, entity := range childPlaces {
-				pointValue, facet := store.MemDb.ReadPointValue(variable, entity, date)
+				pointValue, facet := readMemDbPoint(
+					store.MemDb, variable, entity, date,
+					pb.DateMatch_DATE_MATCH_EXACT, &dateFilter{}, facetFilter)
 				// Override public data from private import
 				if pointValue != nil {
 					facetID := util.GetMetadataHash(facet)
@@ -217,7 +221,8 @@ func BulkPointLinked(
 					// prefer the current cohort.
 					preferredPoint := entityObservation.PointsByFacet[0]
 					for _, point := range entityObservation.PointsByFacet {
-						if stat.IsInferiorFacetMetadata(result.Facets[point.Facet]) {
+						if stat.IsInferiorFacetMetadata(
+							varibleObservation.Variable, result.Facets[point.Facet]) {
 							break
 						}
 						if point.Date > preferredPoint.Date {
@@ -229,5 +234,10 @@ func BulkPointLinked(
 			}
 		}
 	}
+	if targetUnit := in.GetTargetUnit(); targetUnit != "" {
+		if err := convertPointResponse(result, targetUnit); err != nil {
+			return nil, err
+		}
+	}
 	return result, nil
 }
@@ -18,7 +18,6 @@ package observations
 
 import (
 	"context"
-	"sort"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
 	"github.com/datacommonsorg/mixer/internal/server/ranking"
@@ -36,6 +35,16 @@ func BulkSeries(
 	entities := in.GetEntities()
 	variables := in.GetVariables()
 	allFacets := in.GetAllFacets()
+	facetFilter := in.GetFacetFilter()
+	filter, err := newDateFilter(
+		in.GetStartDate(), in.GetEndDate(), in.GetDates(), in.GetLatestN())
+	if err != nil {
+		return nil, err
+	}
+	if err := validateDerivedVariables(
+		in.GetVariables(), in.GetDerivedVariables(), in.GetTargetUnit()); err != nil {
+		return nil, err
+	}
 
 	result := &pb.BulkObservationsSeriesResponse{
 		Facets: map[uint32]*pb.StatMetadata{},
@@ -48,7 +57,7 @@ func BulkSeries(
 	tmpResult := map[string]*pb.VariableObservations{}
 	for _, entity := range entities {
 		for _, variable := range variables {
-			series := btData[entity][variable].SourceSeries
+			series := filterSourceSeries(facetFilter, btData[entity][variable].GetSourceSeries())
 			entityObservations := &pb.EntityObservations{
 				Entity: entity,
 			}
@@ -59,58 +68,41 @@ func BulkSeries(
 			}
 			if len(series) > 0 {
 				// Read series from BT cache
-				sort.Sort(ranking.SeriesByRank(series))
-				if !allFacets && len(series) > 0 {
-					series = series[0:1]
-				}
+				ranking.SortSeries(variable, series)
 				for _, series := range series {
+					points := filter.points(series.Val)
+					// Skip the facets without data for the dates.
+					if !filter.isEmpty() && len(points) == 0 {
+						continue
+					}
 					metadata := stat.GetMetadata(series)
 					facet := util.GetMetadataHash(metadata)
-					timeSeries := &pb.TimeSeries{
-						Facet: facet,
-					}
-					for date, value := range series.Val {
-						ps := &pb.PointStat{
-							Date:  date,
-							Value: value,
-						}
-						timeSeries.Series = append(timeSeries.Series, ps)
-						sort.SliceStable(timeSeries.Series, func(i, j int) bool {
-							return timeSeries.Series[i].Date < timeSeries.Series[j].Date
-						})
-					}
 					entityObservations.SeriesByFacet = append(
 						entityObservations.SeriesByFacet,
-						timeSeries,
+						&pb.TimeSeries{Facet: facet, Series: points},
 					)
 					result.Facets[facet] = metadata
+					if !allFacets {
+						break
+					}
 				}
 			} else if store.MemDb.HasStatVar(variable) {
 				// Read series from in-memory database
-				series := store.MemDb.ReadSeries(variable, entity)
-				if !allFacets && len(series) > 0 {
-					series = series[0:1]
-				}
+				series := filterMemDbSeries(facetFilter, store.MemDb.ReadSeries(variable, entity))
 				for _, series := range series {
-					facet := util.GetMetadataHash(series.Metadata)
-					timeSeries := &pb.TimeSeries{
-						Facet: facet,
-					}
-					for date, value := range series.Val {
-						ps := &pb.PointStat{
-							Date:  date,
-							Value: value,
-						}
-						timeSeries.Series = append(timeSeries.Series, ps)
-						sort.SliceStable(timeSeries.Series, func(i, j int) bool {
-							return timeSeries.Series[i].Date < timeSeries.Series[j].Date
-						})
+					points := filter.points(series.Val)
+					if !filter.isEmpty() && len(points) == 0 {
+						continue
 					}
+					facet := util.GetMetadataHash(series.Metadata)
 					entityObservations.SeriesByFacet = append(
 						entityObservations.SeriesByFacet,
-						timeSeries,
+						&pb.TimeSeries{Facet: facet, Series: points},
 					)
 					result.Facets[facet] = series.Metadata
+					if !allFacets {
+						break
+					}
 				}
 			}
 			tmpResult[variable].ObservationsByEntity = append(
@@ -123,5 +115,15 @@ func BulkSeries(
 		result.ObservationsByVariable = append(
 			result.ObservationsByVariable, tmpResult[variable])
 	}
+	if len(in.GetDerivedVariables()) > 0 {
+		if err := addDerivedSeries(ctx, in, store, result); err != nil {
+			return nil, err
+		}
+	}
+	if targetUnit := in.GetTargetUnit(); targetUnit != "" {
+		if err := convertSeriesResponse(result, targetUnit); err != nil {
+			return nil, err
+		}
+	}
 	return result, nil
 }
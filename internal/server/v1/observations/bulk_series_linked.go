@@ -61,9 +61,11 @@ func BulkSeriesLinked(
 	}
 	childPlaces := childPlacesMap[linkedEntity]
 	req := &pb.BulkObservationsSeriesRequest{
-		Entities:  childPlaces,
-		Variables: variables,
-		AllFacets: allFacets,
+		Entities:    childPlaces,
+		Variables:   variables,
+		AllFacets:   allFacets,
+		FacetFilter: in.GetFacetFilter(),
+		TargetUnit:  in.GetTargetUnit(),
 	}
 	return BulkSeries(ctx, req, store)
 }
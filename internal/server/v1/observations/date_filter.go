@@ -0,0 +1,111 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observations
+
+import (
+	"sort"
+	"strings"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// dateFilter selects the observation dates of a series.
+//
+// Dates are compared as ISO 8601 prefixes, so a start date of "2015" includes
+// "2015-01" and an end date of "2020" includes "2020-12".
+type dateFilter struct {
+	startDate string
+	endDate   string
+	dates     map[string]struct{}
+	latestN   int
+}
+
+func newDateFilter(
+	startDate, endDate string, dates []string, latestN int32,
+) (*dateFilter, error) {
+	if latestN < 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"latest_n should not be negative: %d", latestN)
+	}
+	if startDate != "" && endDate != "" &&
+		startDate > endDate && !strings.HasPrefix(startDate, endDate) {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"start_date %s is after end_date %s", startDate, endDate)
+	}
+	f := &dateFilter{startDate: startDate, endDate: endDate, latestN: int(latestN)}
+	if len(dates) > 0 {
+		f.dates = map[string]struct{}{}
+		for _, date := range dates {
+			f.dates[date] = struct{}{}
+		}
+	}
+	return f, nil
+}
+
+// isEmpty checks whether the filter selects all the dates.
+func (f *dateFilter) isEmpty() bool {
+	return f.startDate == "" && f.endDate == "" && f.dates == nil && f.latestN == 0
+}
+
+// match checks whether a date is in the bounds and the date list of the
+// filter.
+func (f *dateFilter) match(date string) bool {
+	if f.startDate != "" && date < f.startDate {
+		return false
+	}
+	if f.endDate != "" && date > f.endDate && !strings.HasPrefix(date, f.endDate) {
+		return false
+	}
+	if f.dates != nil {
+		if _, ok := f.dates[date]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// points gets the observations of a series that match the filter, sorted by
+// date.
+func (f *dateFilter) points(val map[string]float64) []*pb.PointStat {
+	dates := []string{}
+	for date := range val {
+		if f.match(date) {
+			dates = append(dates, date)
+		}
+	}
+	sort.Strings(dates)
+	if f.latestN > 0 && len(dates) > f.latestN {
+		dates = dates[len(dates)-f.latestN:]
+	}
+	result := []*pb.PointStat{}
+	for _, date := range dates {
+		result = append(result, &pb.PointStat{Date: date, Value: val[date]})
+	}
+	return result
+}
+
+// latest gets the latest observation date of a series that matches the
+// filter, or "" when there is none.
+func (f *dateFilter) latest(val map[string]float64) string {
+	latestDate := ""
+	for date := range val {
+		if date > latestDate && f.match(date) {
+			latestDate = date
+		}
+	}
+	return latestDate
+}
@@ -0,0 +1,108 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observations
+
+import (
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestDateFilter(t *testing.T) {
+	val := map[string]float64{
+		"2014-12": 1,
+		"2015-01": 2,
+		"2016-06": 3,
+		"2020-12": 4,
+		"2021-01": 5,
+	}
+	for _, c := range []struct {
+		startDate string
+		endDate   string
+		dates     []string
+		latestN   int32
+		want      []*pb.PointStat
+		latest    string
+	}{
+		{
+			"", "", nil, 0,
+			[]*pb.PointStat{
+				{Date: "2014-12", Value: 1},
+				{Date: "2015-01", Value: 2},
+				{Date: "2016-06", Value: 3},
+				{Date: "2020-12", Value: 4},
+				{Date: "2021-01", Value: 5},
+			},
+			"2021-01",
+		},
+		{
+			"2015", "2020", nil, 0,
+			[]*pb.PointStat{
+				{Date: "2015-01", Value: 2},
+				{Date: "2016-06", Value: 3},
+				{Date: "2020-12", Value: 4},
+			},
+			"2020-12",
+		},
+		{
+			"", "2020", nil, 2,
+			[]*pb.PointStat{
+				{Date: "2016-06", Value: 3},
+				{Date: "2020-12", Value: 4},
+			},
+			"2020-12",
+		},
+		{
+			"", "", []string{"2015-01", "2021-01", "2022-01"}, 0,
+			[]*pb.PointStat{
+				{Date: "2015-01", Value: 2},
+				{Date: "2021-01", Value: 5},
+			},
+			"2021-01",
+		},
+		{
+			"2022", "", nil, 0,
+			[]*pb.PointStat{},
+			"",
+		},
+	} {
+		f, err := newDateFilter(c.startDate, c.endDate, c.dates, c.latestN)
+		if err != nil {
+			t.Fatalf("newDateFilter() = %s", err)
+		}
+		if diff := cmp.Diff(f.points(val), c.want, protocmp.Transform()); diff != "" {
+			t.Errorf("points(%s, %s, %v, %d) got diff: %v",
+				c.startDate, c.endDate, c.dates, c.latestN, diff)
+		}
+		if got := f.latest(val); got != c.latest {
+			t.Errorf("latest(%s, %s, %v) = %s, want %s",
+				c.startDate, c.endDate, c.dates, got, c.latest)
+		}
+	}
+}
+
+func TestDateFilterInvalid(t *testing.T) {
+	if _, err := newDateFilter("2021", "2020", nil, 0); err == nil {
+		t.Errorf("newDateFilter() got no error for start_date after end_date")
+	}
+	if _, err := newDateFilter("", "", nil, -1); err == nil {
+		t.Errorf("newDateFilter() got no error for negative latest_n")
+	}
+	if _, err := newDateFilter("2020-06", "2020", nil, 0); err != nil {
+		t.Errorf("newDateFilter() = %s for start_date in end_date", err)
+	}
+}
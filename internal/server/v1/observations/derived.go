@@ -0,0 +1,261 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observations
+
+import (
+	"context"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// denominatorSeries is the top ranked series of a denominator variable for an
+// entity.
+type denominatorSeries struct {
+	facet uint32
+	val   map[string]float64
+}
+
+func validateDerivedVariables(
+	variables []string, derived []*pb.DerivedVariable, targetUnit string) error {
+	if len(derived) > 0 && targetUnit != "" {
+		return status.Errorf(codes.InvalidArgument,
+			"target_unit can not be used with derived_variables")
+	}
+	names := map[string]struct{}{}
+	for _, v := range variables {
+		names[v] = struct{}{}
+	}
+	for _, v := range derived {
+		if v.GetName() == "" {
+			return status.Errorf(codes.InvalidArgument,
+				"missing required argument: derived variable name")
+		}
+		if v.GetNumerator() == "" || v.GetDenominator() == "" {
+			return status.Errorf(codes.InvalidArgument,
+				"derived variable %s should have numerator and denominator", v.GetName())
+		}
+		if _, ok := names[v.GetName()]; ok {
+			return status.Errorf(codes.InvalidArgument,
+				"derived variable name %s is already used", v.GetName())
+		}
+		names[v.GetName()] = struct{}{}
+	}
+	return nil
+}
+
+// numeratorsAndDenominators gets the distinct numerator and denominator
+// variables of the derived variables.
+func numeratorsAndDenominators(derived []*pb.DerivedVariable) ([]string, []string) {
+	numerators := []string{}
+	denominators := []string{}
+	seenNumerators := map[string]struct{}{}
+	seenDenominators := map[string]struct{}{}
+	for _, v := range derived {
+		if _, ok := seenNumerators[v.GetNumerator()]; !ok {
+			seenNumerators[v.GetNumerator()] = struct{}{}
+			numerators = append(numerators, v.GetNumerator())
+		}
+		if _, ok := seenDenominators[v.GetDenominator()]; !ok {
+			seenDenominators[v.GetDenominator()] = struct{}{}
+			denominators = append(denominators, v.GetDenominator())
+		}
+	}
+	return numerators, denominators
+}
+
+// readDenominators reads the top ranked series of the denominator variables,
+// keyed by variable and entity, with their facets.
+func readDenominators(
+	ctx context.Context,
+	store *store.Store,
+	entities []string,
+	denominators []string,
+) (map[string]map[string]*denominatorSeries, map[uint32]*pb.StatMetadata, error) {
+	resp, err := BulkSeries(
+		ctx,
+		&pb.BulkObservationsSeriesRequest{Entities: entities, Variables: denominators},
+		store,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	result := map[string]map[string]*denominatorSeries{}
+	for _, variableObs := range resp.ObservationsByVariable {
+		result[variableObs.Variable] = map[string]*denominatorSeries{}
+		for _, entityObs := range variableObs.ObservationsByEntity {
+			if len(entityObs.SeriesByFacet) == 0 {
+				continue
+			}
+			series := entityObs.SeriesByFacet[0]
+			val := map[string]float64{}
+			for _, ps := range series.Series {
+				val[ps.Date] = ps.Value
+			}
+			result[variableObs.Variable][entityObs.Entity] = &denominatorSeries{
+				facet: series.Facet,
+				val:   val,
+			}
+		}
+	}
+	return result, resp.Facets, nil
+}
+
+// derivedFacet gets the facet of the values of a derived variable, which is
+// the numerator facet without the unit and scaling factor, as they do not apply
+// to the ratio.
+func derivedFacet(numFacet *pb.StatMetadata) (uint32, *pb.StatMetadata) {
+	facet := &pb.StatMetadata{}
+	if numFacet != nil {
+		facet = proto.Clone(numFacet).(*pb.StatMetadata)
+	}
+	facet.Unit = ""
+	facet.ScalingFactor = ""
+	return util.GetMetadataHash(facet), facet
+}
+
+// derivePoint computes the point of a derived variable from a point of the
+// numerator with the given facet, or nil when the denominator has no data for
+// the date. The facet of the point is set by the caller.
+func derivePoint(
+	v *pb.DerivedVariable, num *pb.PointStat, numFacet uint32, den *denominatorSeries,
+) *pb.PointStat {
+	if den == nil {
+		return nil
+	}
+	denDate := util.MatchDate(den.val, num.Date, v.GetDenominatorDateMatch())
+	if denDate == "" || den.val[denDate] == 0 {
+		return nil
+	}
+	scaling := v.GetScaling()
+	if scaling == 0 {
+		scaling = 1
+	}
+	return &pb.PointStat{
+		Date:             num.Date,
+		Value:            num.Value / den.val[denDate] * scaling,
+		NumeratorFacet:   numFacet,
+		DenominatorFacet: den.facet,
+		DenominatorDate:  denDate,
+	}
+}
+
+// addDerivedPoints computes the points of the derived variables of a
+// BulkObservationsPoint request and adds them to the response.
+func addDerivedPoints(
+	ctx context.Context,
+	in *pb.BulkObservationsPointRequest,
+	store *store.Store,
+	result *pb.BulkObservationsPointResponse,
+) error {
+	numerators, denominators := numeratorsAndDenominators(in.GetDerivedVariables())
+	req := proto.Clone(in).(*pb.BulkObservationsPointRequest)
+	req.Variables = numerators
+	req.DerivedVariables = nil
+	numResp, err := BulkPoint(ctx, req, store)
+	if err != nil {
+		return err
+	}
+	denData, denFacets, err := readDenominators(ctx, store, in.GetEntities(), denominators)
+	if err != nil {
+		return err
+	}
+	numData := map[string]*pb.VariableObservations{}
+	for _, variableObs := range numResp.ObservationsByVariable {
+		numData[variableObs.Variable] = variableObs
+	}
+	for _, v := range in.GetDerivedVariables() {
+		variableObs := &pb.VariableObservations{Variable: v.GetName()}
+		for _, numObs := range numData[v.GetNumerator()].GetObservationsByEntity() {
+			entityObs := &pb.EntityObservations{Entity: numObs.Entity}
+			for _, num := range numObs.PointsByFacet {
+				ps := derivePoint(
+					v, num, num.Facet, denData[v.GetDenominator()][numObs.Entity])
+				if ps == nil {
+					continue
+				}
+				facetID, facet := derivedFacet(numResp.Facets[num.Facet])
+				ps.Facet = facetID
+				entityObs.PointsByFacet = append(entityObs.PointsByFacet, ps)
+				result.Facets[facetID] = facet
+				result.Facets[num.Facet] = numResp.Facets[num.Facet]
+				result.Facets[ps.DenominatorFacet] = denFacets[ps.DenominatorFacet]
+			}
+			variableObs.ObservationsByEntity = append(
+				variableObs.ObservationsByEntity, entityObs)
+		}
+		result.ObservationsByVariable = append(result.ObservationsByVariable, variableObs)
+	}
+	return nil
+}
+
+// addDerivedSeries computes the series of the derived variables of a
+// BulkObservationsSeries request and adds them to the response.
+func addDerivedSeries(
+	ctx context.Context,
+	in *pb.BulkObservationsSeriesRequest,
+	store *store.Store,
+	result *pb.BulkObservationsSeriesResponse,
+) error {
+	numerators, denominators := numeratorsAndDenominators(in.GetDerivedVariables())
+	req := proto.Clone(in).(*pb.BulkObservationsSeriesRequest)
+	req.Variables = numerators
+	req.DerivedVariables = nil
+	numResp, err := BulkSeries(ctx, req, store)
+	if err != nil {
+		return err
+	}
+	denData, denFacets, err := readDenominators(ctx, store, in.GetEntities(), denominators)
+	if err != nil {
+		return err
+	}
+	numData := map[string]*pb.VariableObservations{}
+	for _, variableObs := range numResp.ObservationsByVariable {
+		numData[variableObs.Variable] = variableObs
+	}
+	for _, v := range in.GetDerivedVariables() {
+		variableObs := &pb.VariableObservations{Variable: v.GetName()}
+		for _, numObs := range numData[v.GetNumerator()].GetObservationsByEntity() {
+			entityObs := &pb.EntityObservations{Entity: numObs.Entity}
+			den := denData[v.GetDenominator()][numObs.Entity]
+			for _, numSeries := range numObs.SeriesByFacet {
+				facetID, facet := derivedFacet(numResp.Facets[numSeries.Facet])
+				series := &pb.TimeSeries{Facet: facetID}
+				for _, num := range numSeries.Series {
+					ps := derivePoint(v, num, numSeries.Facet, den)
+					if ps == nil {
+						continue
+					}
+					series.Series = append(series.Series, ps)
+					result.Facets[ps.DenominatorFacet] = denFacets[ps.DenominatorFacet]
+				}
+				if len(series.Series) == 0 {
+					continue
+				}
+				entityObs.SeriesByFacet = append(entityObs.SeriesByFacet, series)
+				result.Facets[facetID] = facet
+				result.Facets[numSeries.Facet] = numResp.Facets[numSeries.Facet]
+			}
+			variableObs.ObservationsByEntity = append(
+				variableObs.ObservationsByEntity, entityObs)
+		}
+		result.ObservationsByVariable = append(result.ObservationsByVariable, variableObs)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observations
+
+import (
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestDerivePoint(t *testing.T) {
+	den := &denominatorSeries{
+		facet: 2,
+		val: map[string]float64{
+			"2019": 1000,
+			"2020": 0,
+			"2021": 2000,
+		},
+	}
+	for _, c := range []struct {
+		v    *pb.DerivedVariable
+		num  *pb.PointStat
+		den  *denominatorSeries
+		want *pb.PointStat
+	}{
+		{
+			&pb.DerivedVariable{Name: "rate"},
+			&pb.PointStat{Date: "2019", Value: 50, Facet: 1},
+			den,
+			&pb.PointStat{
+				Date: "2019", Value: 0.05,
+				NumeratorFacet: 1, DenominatorFacet: 2, DenominatorDate: "2019",
+			},
+		},
+		{
+			&pb.DerivedVariable{Name: "rate", Scaling: 100},
+			&pb.PointStat{Date: "2021", Value: 50, Facet: 1},
+			den,
+			&pb.PointStat{
+				Date: "2021", Value: 2.5,
+				NumeratorFacet: 1, DenominatorFacet: 2, DenominatorDate: "2021",
+			},
+		},
+		{
+			&pb.DerivedVariable{Name: "rate"},
+			&pb.PointStat{Date: "2019-06", Value: 50, Facet: 1},
+			den,
+			nil,
+		},
+		{
+			&pb.DerivedVariable{
+				Name:                 "rate",
+				DenominatorDateMatch: pb.DateMatch_DATE_MATCH_NEAREST_BEFORE,
+			},
+			&pb.PointStat{Date: "2022-03", Value: 50, Facet: 1},
+			den,
+			&pb.PointStat{
+				Date: "2022-03", Value: 0.025,
+				NumeratorFacet: 1, DenominatorFacet: 2, DenominatorDate: "2021",
+			},
+		},
+		{
+			&pb.DerivedVariable{Name: "rate"},
+			&pb.PointStat{Date: "2020", Value: 50, Facet: 1},
+			den,
+			nil,
+		},
+		{
+			&pb.DerivedVariable{Name: "rate"},
+			&pb.PointStat{Date: "2019", Value: 50, Facet: 1},
+			nil,
+			nil,
+		},
+	} {
+		got := derivePoint(c.v, c.num, c.num.Facet, c.den)
+		if diff := cmp.Diff(got, c.want, protocmp.Transform()); diff != "" {
+			t.Errorf("derivePoint(%v, %v) got diff: %v", c.v, c.num, diff)
+		}
+	}
+}
+
+func TestValidateDerivedVariables(t *testing.T) {
+	for _, c := range []struct {
+		variables  []string
+		derived    []*pb.DerivedVariable
+		targetUnit string
+		ok         bool
+	}{
+		{nil, nil, "", true},
+		{nil, nil, "KilowattHour", true},
+		{[]string{"b"}, []*pb.DerivedVariable{{Name: "a", Numerator: "b", Denominator: "c"}}, "", true},
+		{nil, []*pb.DerivedVariable{{Name: "a", Numerator: "b", Denominator: "c"}}, "KilowattHour", false},
+		{nil, []*pb.DerivedVariable{{Numerator: "b", Denominator: "c"}}, "", false},
+		{nil, []*pb.DerivedVariable{{Name: "a", Numerator: "b"}}, "", false},
+		{[]string{"a"}, []*pb.DerivedVariable{{Name: "a", Numerator: "b", Denominator: "c"}}, "", false},
+		{nil, []*pb.DerivedVariable{
+			{Name: "a", Numerator: "b", Denominator: "c"},
+			{Name: "a", Numerator: "d", Denominator: "c"},
+		}, "", false},
+	} {
+		if err := validateDerivedVariables(c.variables, c.derived, c.targetUnit); (err == nil) != c.ok {
+			t.Errorf("validateDerivedVariables(%v) = %v", c.derived, err)
+		}
+	}
+}
+
+func TestDerivedFacet(t *testing.T) {
+	numFacet := &pb.StatMetadata{
+		ImportName:        "CensusACS5YearSurvey",
+		MeasurementMethod: "CensusACS5yrSurvey",
+		Unit:              "USDollar",
+		ScalingFactor:     "1000",
+	}
+	want := &pb.StatMetadata{
+		ImportName:        "CensusACS5YearSurvey",
+		MeasurementMethod: "CensusACS5yrSurvey",
+	}
+	id, got := derivedFacet(numFacet)
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("derivedFacet() got diff: %v", diff)
+	}
+	if id != util.GetMetadataHash(want) {
+		t.Errorf("derivedFacet() got id %d, want %d", id, util.GetMetadataHash(want))
+	}
+	if numFacet.Unit != "USDollar" {
+		t.Errorf("derivedFacet() changed the numerator facet: %v", numFacet)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observations
+
+import (
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/stat"
+	"github.com/datacommonsorg/mixer/internal/util"
+)
+
+// isEmptyFacetFilter checks whether the facet filter selects all the facets.
+func isEmptyFacetFilter(filter *pb.FacetFilter) bool {
+	return filter.GetImportName() == "" &&
+		filter.GetMeasurementMethod() == "" &&
+		filter.GetObservationPeriod() == "" &&
+		filter.GetUnit() == "" &&
+		filter.GetScalingFactor() == "" &&
+		len(filter.GetFacetIds()) == 0
+}
+
+// matchFacet checks whether the metadata of a facet matches the facet filter.
+func matchFacet(filter *pb.FacetFilter, metadata *pb.StatMetadata) bool {
+	for _, c := range []struct {
+		want string
+		got  string
+	}{
+		{filter.GetImportName(), metadata.GetImportName()},
+		{filter.GetMeasurementMethod(), metadata.GetMeasurementMethod()},
+		{filter.GetObservationPeriod(), metadata.GetObservationPeriod()},
+		{filter.GetUnit(), metadata.GetUnit()},
+		{filter.GetScalingFactor(), metadata.GetScalingFactor()},
+	} {
+		if c.want != "" && c.want != c.got {
+			return false
+		}
+	}
+	if len(filter.GetFacetIds()) > 0 {
+		facetID := util.GetMetadataHash(metadata)
+		for _, id := range filter.GetFacetIds() {
+			if id == facetID {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// filterSourceSeries gets the Bigtable series that match the facet filter.
+func filterSourceSeries(
+	filter *pb.FacetFilter, series []*pb.SourceSeries,
+) []*pb.SourceSeries {
+	if isEmptyFacetFilter(filter) {
+		return series
+	}
+	result := []*pb.SourceSeries{}
+	for _, s := range series {
+		if matchFacet(filter, stat.GetMetadata(s)) {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// filterMemDbSeries gets the in-memory database series that match the facet
+// filter.
+func filterMemDbSeries(filter *pb.FacetFilter, series []*pb.Series) []*pb.Series {
+	if isEmptyFacetFilter(filter) {
+		return series
+	}
+	result := []*pb.Series{}
+	for _, s := range series {
+		if matchFacet(filter, s.Metadata) {
+			result = append(result, s)
+		}
+	}
+	return result
+}
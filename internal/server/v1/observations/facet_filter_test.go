@@ -0,0 +1,60 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observations
+
+import (
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/stat"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFilterSourceSeries(t *testing.T) {
+	census := &pb.SourceSeries{
+		ImportName:        "CensusACS5YearSurvey",
+		MeasurementMethod: "CensusACS5yrSurvey",
+		ProvenanceUrl:     "https://www.census.gov/",
+	}
+	wiki := &pb.SourceSeries{
+		ImportName:    "WikidataPopulation",
+		Unit:          "Person",
+		ProvenanceUrl: "https://www.wikidata.org/",
+	}
+	series := []*pb.SourceSeries{census, wiki}
+	wikiID := util.GetMetadataHash(stat.GetMetadata(wiki))
+
+	for _, c := range []struct {
+		filter *pb.FacetFilter
+		want   []string
+	}{
+		{nil, []string{"CensusACS5YearSurvey", "WikidataPopulation"}},
+		{&pb.FacetFilter{}, []string{"CensusACS5YearSurvey", "WikidataPopulation"}},
+		{&pb.FacetFilter{ImportName: "WikidataPopulation"}, []string{"WikidataPopulation"}},
+		{&pb.FacetFilter{MeasurementMethod: "CensusACS5yrSurvey"}, []string{"CensusACS5YearSurvey"}},
+		{&pb.FacetFilter{Unit: "Person", ImportName: "CensusACS5YearSurvey"}, []string{}},
+		{&pb.FacetFilter{FacetIds: []uint32{wikiID}}, []string{"WikidataPopulation"}},
+		{&pb.FacetFilter{FacetIds: []uint32{wikiID + 1}}, []string{}},
+	} {
+		got := []string{}
+		for _, s := range filterSourceSeries(c.filter, series) {
+			got = append(got, s.ImportName)
+		}
+		if diff := cmp.Diff(got, c.want); diff != "" {
+			t.Errorf("filterSourceSeries(%v) got diff: %v", c.filter, diff)
+		}
+	}
+}
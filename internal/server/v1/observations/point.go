@@ -47,10 +47,20 @@ func Point(
 	if err != nil {
 		return nil, err
 	}
-	stat, metadata := stat.GetValueFromBestSourcePb(btData[entity][variable], date)
+	obsTimeSeries := btData[entity][variable]
+	if obsTimeSeries != nil {
+		obsTimeSeries.SourceSeries = filterSourceSeries(
+			in.GetFacetFilter(), obsTimeSeries.SourceSeries)
+	}
+	stat, metadata := stat.GetValueFromBestSourcePb(obsTimeSeries, variable, date)
 	if stat == nil {
 		return &pb.PointStat{}, nil
 	}
 	stat.Metadata = metadata
+	if targetUnit := in.GetTargetUnit(); targetUnit != "" {
+		if err := convertPoint(stat, targetUnit); err != nil {
+			return nil, err
+		}
+	}
 	return stat, nil
 }
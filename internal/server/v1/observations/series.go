@@ -16,9 +16,9 @@ package observations
 
 import (
 	"context"
-	"sort"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/convert"
 	"github.com/datacommonsorg/mixer/internal/server/ranking"
 	"github.com/datacommonsorg/mixer/internal/server/stat"
 	"github.com/datacommonsorg/mixer/internal/store"
@@ -43,6 +43,11 @@ func Series(
 		return nil, status.Errorf(codes.InvalidArgument,
 			"Missing required argument: variable")
 	}
+	filter, err := newDateFilter(
+		in.GetStartDate(), in.GetEndDate(), in.GetDates(), in.GetLatestN())
+	if err != nil {
+		return nil, err
+	}
 	resp := &pb.ObservationsSeriesResponse{}
 	btData, err := stat.ReadStatsPb(
 		ctx, store.BtGroup, []string{entity}, []string{variable})
@@ -57,22 +62,31 @@ func Series(
 	if !ok {
 		return resp, err
 	}
-	series := variableData.SourceSeries
+	series := filterSourceSeries(in.GetFacetFilter(), variableData.SourceSeries)
 	if len(series) == 0 {
 		return resp, err
 	}
-	sort.Sort(ranking.SeriesByRank(series))
-	resp.Facet = stat.GetMetadata(series[0])
-	dates := []string{}
-	for date := range series[0].Val {
-		dates = append(dates, date)
+	ranking.SortSeries(variable, series)
+	// Use the top ranked series with data for the dates.
+	for _, series := range series {
+		points := filter.points(series.Val)
+		if !filter.isEmpty() && len(points) == 0 {
+			continue
+		}
+		resp.Facet = stat.GetMetadata(series)
+		resp.Observations = points
+		break
 	}
-	sort.Strings(dates)
-	for _, date := range dates {
-		resp.Observations = append(resp.Observations, &pb.PointStat{
-			Date:  date,
-			Value: series[0].Val[date],
-		})
+	if targetUnit := in.GetTargetUnit(); targetUnit != "" && resp.Facet != nil {
+		factor, err := convert.UnitFactor(
+			resp.Facet.GetUnit(), resp.Facet.GetScalingFactor(), targetUnit)
+		if err != nil {
+			return nil, err
+		}
+		for _, ps := range resp.Observations {
+			ps.Value *= factor
+		}
+		resp.Facet = convertMetadata(resp.Facet, targetUnit)
 	}
 	return resp, nil
 }
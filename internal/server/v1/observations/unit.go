@@ -0,0 +1,144 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observations
+
+import (
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/server/convert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// convertMetadata gets a copy of the metadata in the target unit.
+func convertMetadata(metadata *pb.StatMetadata, targetUnit string) *pb.StatMetadata {
+	result := proto.Clone(metadata).(*pb.StatMetadata)
+	result.Unit = targetUnit
+	result.ScalingFactor = ""
+	return result
+}
+
+// validateTargetUnit checks that values can be converted to the target unit.
+func validateTargetUnit(targetUnit string) error {
+	if _, ok := convert.Units[targetUnit]; !ok {
+		return status.Errorf(codes.InvalidArgument,
+			"unsupported target unit: %s", targetUnit)
+	}
+	return nil
+}
+
+// convertFacets converts the facets used in a response to the target unit,
+// and gets the factors to convert the values of each facet. Unused facets are
+// dropped. It is an error when a used facet can not be converted, like one
+// without a unit. The facet ids are kept, so they still match the facet filter.
+func convertFacets(
+	facets map[uint32]*pb.StatMetadata, used map[uint32]struct{}, targetUnit string,
+) (map[uint32]float64, error) {
+	factors := map[uint32]float64{}
+	for id := range used {
+		metadata := facets[id]
+		factor, err := convert.UnitFactor(
+			metadata.GetUnit(), metadata.GetScalingFactor(), targetUnit)
+		if err != nil {
+			return nil, err
+		}
+		factors[id] = factor
+	}
+	for id, metadata := range facets {
+		if _, ok := used[id]; !ok {
+			delete(facets, id)
+			continue
+		}
+		facets[id] = convertMetadata(metadata, targetUnit)
+	}
+	return factors, nil
+}
+
+// convertPoint converts a point with full metadata to the target unit.
+func convertPoint(ps *pb.PointStat, targetUnit string) error {
+	if ps.Metadata == nil {
+		return nil
+	}
+	factor, err := convert.UnitFactor(
+		ps.Metadata.GetUnit(), ps.Metadata.GetScalingFactor(), targetUnit)
+	if err != nil {
+		return err
+	}
+	ps.Value *= factor
+	ps.Metadata = convertMetadata(ps.Metadata, targetUnit)
+	return nil
+}
+
+// convertPointResponse converts the points of a BulkObservationsPoint response
+// to the target unit.
+func convertPointResponse(
+	resp *pb.BulkObservationsPointResponse, targetUnit string,
+) error {
+	if err := validateTargetUnit(targetUnit); err != nil {
+		return err
+	}
+	used := map[uint32]struct{}{}
+	for _, variableObs := range resp.ObservationsByVariable {
+		for _, entityObs := range variableObs.ObservationsByEntity {
+			for _, ps := range entityObs.PointsByFacet {
+				used[ps.Facet] = struct{}{}
+			}
+		}
+	}
+	factors, err := convertFacets(resp.Facets, used, targetUnit)
+	if err != nil {
+		return err
+	}
+	for _, variableObs := range resp.ObservationsByVariable {
+		for _, entityObs := range variableObs.ObservationsByEntity {
+			for _, ps := range entityObs.PointsByFacet {
+				ps.Value *= factors[ps.Facet]
+			}
+		}
+	}
+	return nil
+}
+
+// convertSeriesResponse converts the series of a BulkObservationsSeries
+// response to the target unit.
+func convertSeriesResponse(
+	resp *pb.BulkObservationsSeriesResponse, targetUnit string,
+) error {
+	if err := validateTargetUnit(targetUnit); err != nil {
+		return err
+	}
+	used := map[uint32]struct{}{}
+	for _, variableObs := range resp.ObservationsByVariable {
+		for _, entityObs := range variableObs.ObservationsByEntity {
+			for _, series := range entityObs.SeriesByFacet {
+				used[series.Facet] = struct{}{}
+			}
+		}
+	}
+	factors, err := convertFacets(resp.Facets, used, targetUnit)
+	if err != nil {
+		return err
+	}
+	for _, variableObs := range resp.ObservationsByVariable {
+		for _, entityObs := range variableObs.ObservationsByEntity {
+			for _, series := range entityObs.SeriesByFacet {
+				for _, ps := range series.Series {
+					ps.Value *= factors[series.Facet]
+				}
+			}
+		}
+	}
+	return nil
+}
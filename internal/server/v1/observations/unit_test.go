@@ -0,0 +1,143 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package observations
+
+import (
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestConvertPointResponse(t *testing.T) {
+	resp := &pb.BulkObservationsPointResponse{
+		ObservationsByVariable: []*pb.VariableObservations{
+			{
+				Variable: "Annual_Generation_Electricity",
+				ObservationsByEntity: []*pb.EntityObservations{
+					{
+						Entity: "country/USA",
+						PointsByFacet: []*pb.PointStat{
+							{Date: "2020", Value: 4, Facet: 1},
+						},
+					},
+					{
+						Entity: "geoId/06",
+						PointsByFacet: []*pb.PointStat{
+							{Date: "2020", Value: 2000, Facet: 2},
+						},
+					},
+				},
+			},
+		},
+		Facets: map[uint32]*pb.StatMetadata{
+			1: {ImportName: "EIA", Unit: "GigawattHour"},
+			2: {ImportName: "EIA", Unit: "MegawattHour", ScalingFactor: "1000"},
+			// Not used by any point.
+			3: {ImportName: "Other", Unit: "Kilowatt"},
+		},
+	}
+	want := &pb.BulkObservationsPointResponse{
+		ObservationsByVariable: []*pb.VariableObservations{
+			{
+				Variable: "Annual_Generation_Electricity",
+				ObservationsByEntity: []*pb.EntityObservations{
+					{
+						Entity: "country/USA",
+						PointsByFacet: []*pb.PointStat{
+							{Date: "2020", Value: 4000000, Facet: 1},
+						},
+					},
+					{
+						Entity: "geoId/06",
+						PointsByFacet: []*pb.PointStat{
+							{Date: "2020", Value: 2000, Facet: 2},
+						},
+					},
+				},
+			},
+		},
+		Facets: map[uint32]*pb.StatMetadata{
+			1: {ImportName: "EIA", Unit: "KilowattHour"},
+			2: {ImportName: "EIA", Unit: "KilowattHour"},
+		},
+	}
+	if err := convertPointResponse(resp, "KilowattHour"); err != nil {
+		t.Fatalf("convertPointResponse() = %s", err)
+	}
+	if diff := cmp.Diff(resp, want, protocmp.Transform()); diff != "" {
+		t.Errorf("convertPointResponse() got diff: %v", diff)
+	}
+
+	if err := convertPointResponse(resp, "Furlong"); err == nil {
+		t.Errorf("convertPointResponse() with unsupported target unit should fail")
+	}
+}
+
+func TestConvertResponseUnitless(t *testing.T) {
+	facets := func() map[uint32]*pb.StatMetadata {
+		return map[uint32]*pb.StatMetadata{
+			1: {ImportName: "EIA", Unit: "GigawattHour"},
+			// Without a unit, so it can not be converted.
+			2: {ImportName: "Other"},
+		}
+	}
+	pointResp := &pb.BulkObservationsPointResponse{
+		ObservationsByVariable: []*pb.VariableObservations{
+			{
+				Variable: "Annual_Generation_Electricity",
+				ObservationsByEntity: []*pb.EntityObservations{
+					{
+						Entity: "country/USA",
+						PointsByFacet: []*pb.PointStat{
+							{Date: "2020", Value: 4, Facet: 1},
+							{Date: "2020", Value: 4100, Facet: 2},
+						},
+					},
+				},
+			},
+		},
+		Facets: facets(),
+	}
+	err := convertPointResponse(pointResp, "KilowattHour")
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("convertPointResponse() = %v, want InvalidArgument", err)
+	}
+
+	seriesResp := &pb.BulkObservationsSeriesResponse{
+		ObservationsByVariable: []*pb.VariableObservations{
+			{
+				Variable: "Annual_Generation_Electricity",
+				ObservationsByEntity: []*pb.EntityObservations{
+					{
+						Entity: "country/USA",
+						SeriesByFacet: []*pb.TimeSeries{
+							{Series: []*pb.PointStat{{Date: "2020", Value: 4}}, Facet: 1},
+							{Series: []*pb.PointStat{{Date: "2020", Value: 4100}}, Facet: 2},
+						},
+					},
+				},
+			},
+		},
+		Facets: facets(),
+	}
+	err = convertSeriesResponse(seriesResp, "KilowattHour")
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("convertSeriesResponse() = %v, want InvalidArgument", err)
+	}
+}
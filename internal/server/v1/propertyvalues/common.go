@@ -49,3 +49,43 @@ var unmarshalFunc = func(jsonRaw []byte) (interface{}, error) {
 	err := proto.Unmarshal(jsonRaw, &p)
 	return &p, err
 }
+
+// remapCursors re-maps the cursors of a token from the import group order it
+// was created with to the current order, which changes when the import group
+// rank is reloaded. It returns false when the import groups are not the same.
+func remapCursors(cursorGroups []*pb.CursorGroup, from, to []string) bool {
+	if len(from) != len(to) {
+		return false
+	}
+	// Index in from of each import group in to.
+	index := make([]int, len(to))
+	used := make([]bool, len(from))
+	for j, g := range to {
+		found := false
+		for i, h := range from {
+			if !used[i] && h == g {
+				index[j], used[i], found = i, true, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, cg := range cursorGroups {
+		if len(cg.Cursors) != len(from) {
+			return false
+		}
+	}
+	for _, cg := range cursorGroups {
+		cursors := make([]*pb.Cursor, len(to))
+		for j, i := range index {
+			cursors[j] = cg.Cursors[i]
+			if cursors[j] != nil {
+				cursors[j].ImportGroup = int32(j)
+			}
+		}
+		cg.Cursors = cursors
+	}
+	return true
+}
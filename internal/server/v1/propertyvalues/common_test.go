@@ -0,0 +1,62 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propertyvalues
+
+import (
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestRemapCursors(t *testing.T) {
+	cursorGroups := func() []*pb.CursorGroup {
+		return []*pb.CursorGroup{{
+			Keys: []string{"geoId/06", "containedInPlace"},
+			Cursors: []*pb.Cursor{
+				{ImportGroup: 0, Page: 1, Item: 2},
+				{ImportGroup: 1, Page: 3, Item: 4},
+				{ImportGroup: 2, Page: 5, Item: 6},
+			},
+		}}
+	}
+	from := []string{"frequent", "ipcc", "infrequent"}
+
+	got := cursorGroups()
+	if !remapCursors(got, from, []string{"ipcc", "frequent", "infrequent"}) {
+		t.Fatalf("remapCursors() with reordered import groups = false")
+	}
+	want := []*pb.CursorGroup{{
+		Keys: []string{"geoId/06", "containedInPlace"},
+		Cursors: []*pb.Cursor{
+			{ImportGroup: 0, Page: 3, Item: 4},
+			{ImportGroup: 1, Page: 1, Item: 2},
+			{ImportGroup: 2, Page: 5, Item: 6},
+		},
+	}}
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("remapCursors() got diff %v", diff)
+	}
+
+	for _, to := range [][]string{
+		{"frequent", "ipcc"},
+		{"frequent", "biomedical", "infrequent"},
+	} {
+		if remapCursors(cursorGroups(), from, to) {
+			t.Errorf("remapCursors() from %v to %v = true", from, to)
+		}
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/datacommonsorg/mixer/internal/server/pagination"
 	"github.com/datacommonsorg/mixer/internal/store"
 	"github.com/datacommonsorg/mixer/internal/store/bigtable"
+	"github.com/datacommonsorg/mixer/internal/store/memdb"
 	"github.com/datacommonsorg/mixer/internal/util"
 
 	"google.golang.org/grpc/codes"
@@ -31,6 +32,9 @@ import (
 
 // Fetch is the generic handler to fetch property values for multiple
 // properties and entities.
+//
+// The values of the private import in memdb are served after the Bigtable
+// values of each property and entity.
 func Fetch(
 	ctx context.Context,
 	store *store.Store,
@@ -43,19 +47,142 @@ func Fetch(
 	map[string]map[string][]*pb.EntityInfo,
 	*pb.PaginationInfo,
 	error,
+) {
+	if limit == 0 || limit > defaultLimit {
+		limit = defaultLimit
+	}
+	data, pi, err := fetchBt(ctx, store, properties, entities, limit, token, direction)
+	if err != nil {
+		return nil, nil, err
+	}
+	if store.MemDb == nil {
+		return data, pi, nil
+	}
+	// Key: property, entity
+	memDbItems := map[string]map[string]int32{}
+	if token != "" {
+		tokenInfo, err := pagination.Decode(token)
+		if err != nil {
+			return nil, nil, status.Errorf(
+				codes.InvalidArgument, "invalid pagination token: %s", token)
+		}
+		for _, g := range tokenInfo.GetCursorGroups() {
+			// First key is entity, second key is property, as checked by fetchBt.
+			p, e := g.GetKeys()[1], g.GetKeys()[0]
+			if _, ok := memDbItems[p]; !ok {
+				memDbItems[p] = map[string]int32{}
+			}
+			memDbItems[p][e] = g.GetMemdbItem()
+		}
+	}
+	pi = mergeMemDb(store.MemDb, properties, entities, direction, limit, memDbItems, data, pi)
+	return data, pi, nil
+}
+
+// mergeMemDb adds the property values in memdb to a page, from the positions
+// in memDbItems and up to the limit. It returns the pagination info with the
+// positions of the next memdb values.
+//
+// The memdb values of a property and entity are read after its Bigtable
+// values, which are exhausted when the page is not full. Values that are
+// already on the page are skipped.
+func mergeMemDb(
+	memDb *memdb.MemDb,
+	properties []string,
+	entities []string,
+	direction string,
+	limit int,
+	memDbItems map[string]map[string]int32,
+	data map[string]map[string][]*pb.EntityInfo,
+	pi *pb.PaginationInfo,
+) *pb.PaginationInfo {
+	// Key: property, entity
+	nextItems := map[string]map[string]int32{}
+	hasNext := false
+	for _, p := range properties {
+		nextItems[p] = map[string]int32{}
+		for _, e := range entities {
+			values := memDb.ReadPropertyValues(e, p, direction)
+			item := memDbItems[p][e]
+			if len(data[p][e]) < limit && int(item) < len(values) {
+				if _, ok := data[p]; !ok {
+					data[p] = map[string][]*pb.EntityInfo{}
+				}
+				seen := map[string]struct{}{}
+				for _, v := range data[p][e] {
+					seen[v.Dcid+"|"+v.Value] = struct{}{}
+				}
+				for ; int(item) < len(values) && len(data[p][e]) < limit; item++ {
+					v := values[item]
+					if _, ok := seen[v.Dcid+"|"+v.Value]; ok {
+						continue
+					}
+					data[p][e] = append(data[p][e], v)
+				}
+			}
+			nextItems[p][e] = item
+			if int(item) < len(values) {
+				hasNext = true
+			}
+		}
+	}
+	if pi == nil {
+		if !hasNext {
+			return nil
+		}
+		// The Bigtable values are exhausted, so there are no cursors.
+		pi = &pb.PaginationInfo{}
+		for _, p := range properties {
+			for _, e := range entities {
+				pi.CursorGroups = append(pi.CursorGroups, &pb.CursorGroup{Keys: []string{e, p}})
+			}
+		}
+	}
+	for _, g := range pi.CursorGroups {
+		g.MemdbItem = nextItems[g.Keys[1]][g.Keys[0]]
+	}
+	return pi
+}
+
+// fetchBt fetches a page of property values from Bigtable.
+func fetchBt(
+	ctx context.Context,
+	store *store.Store,
+	properties []string,
+	entities []string,
+	limit int,
+	token string,
+	direction string,
+) (
+	map[string]map[string][]*pb.EntityInfo,
+	*pb.PaginationInfo,
+	error,
 ) {
 	var err error
+	// Read from one copy of the tables, so the cursors match the tables read
+	// even if the tables are updated during the request.
+	btGroup := store.BtGroup.Copy()
+	// The cursors index into the tables, so a token is only valid for the
+	// import groups it was created with. Their order can change.
+	importGroups := []string{}
+	for _, name := range btGroup.TableNames() {
+		importGroups = append(importGroups, bigtable.ImportGroupName(name))
+	}
 	// Empty cursor groups when no token is given.
 	var cursorGroups []*pb.CursorGroup
 	if token == "" {
-		cursorGroups = buildDefaultCursorGroups(
-			properties, entities, len(store.BtGroup.Tables()))
+		cursorGroups = buildDefaultCursorGroups(properties, entities, len(importGroups))
 	} else {
 		pi, err := pagination.Decode(token)
 		if err != nil {
 			return nil, nil, status.Errorf(
 				codes.InvalidArgument, "invalid pagination token: %s", token)
 		}
+		if len(pi.GetImportGroups()) > 0 &&
+			!remapCursors(pi.CursorGroups, pi.GetImportGroups(), importGroups) {
+			return nil, nil, status.Errorf(codes.InvalidArgument,
+				"stale pagination token, the import groups have changed: %s", token)
+		}
 		cursorGroups = pi.CursorGroups
 	}
 	if limit == 0 || limit > defaultLimit {
@@ -77,11 +204,12 @@ func Fetch(
 	}
 	if direction == util.DirectionOut {
 		s := &outState{}
-		if err = s.init(ctx, store.BtGroup, properties, entities, limit, cursorGroup); err != nil {
+		s.importGroups = importGroups
+		if err = s.init(ctx, btGroup, properties, entities, limit, cursorGroup); err != nil {
 			return nil, nil, err
 		}
 		for {
-			hasNext, err := nextOut(ctx, s, store.BtGroup)
+			hasNext, err := nextOut(ctx, s, btGroup)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -101,11 +229,12 @@ func Fetch(
 		return s.mergedEntities, nil, nil
 	} else {
 		s := &inState{}
-		if err = s.init(ctx, store.BtGroup, properties, entities, limit, cursorGroup); err != nil {
+		s.importGroups = importGroups
+		if err = s.init(ctx, btGroup, properties, entities, limit, cursorGroup); err != nil {
 			return nil, nil, err
 		}
 		for {
-			hasNext, err := nextIn(ctx, s, store.BtGroup)
+			hasNext, err := nextIn(ctx, s, btGroup)
 			if err != nil {
 				return nil, nil, err
 			}
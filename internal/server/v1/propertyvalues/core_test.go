@@ -0,0 +1,186 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package propertyvalues
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"github.com/datacommonsorg/mixer/internal/store/bigtable"
+	"github.com/datacommonsorg/mixer/internal/store/memdb"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFetchImportGroupsOfToken(t *testing.T) {
+	ctx := context.Background()
+	st := store.NewStore(nil, nil, []*bigtable.Table{
+		bigtable.NewTableWithBackend("frequent_2022_02", bigtable.NewLocalBackend(nil)),
+		bigtable.NewTableWithBackend("infrequent_2022_01", bigtable.NewLocalBackend(nil)),
+	}, "")
+	token := func(importGroups ...string) string {
+		result, err := util.EncodeProto(&pb.PaginationInfo{
+			CursorGroups: buildDefaultCursorGroups(
+				[]string{"containedInPlace"}, []string{"geoId/06"}, 2),
+			ImportGroups: importGroups,
+		})
+		if err != nil {
+			t.Fatalf("EncodeProto() = %s", err)
+		}
+		return result
+	}
+	for _, c := range []struct {
+		token string
+		want  codes.Code
+	}{
+		{token("frequent", "infrequent"), codes.OK},
+		{token("infrequent", "frequent"), codes.OK},
+		// Tokens without import groups are taken as is.
+		{token(), codes.OK},
+		{token("frequent", "ipcc"), codes.InvalidArgument},
+	} {
+		_, _, err := Fetch(ctx, st, []string{"containedInPlace"}, []string{"geoId/06"},
+			0, c.token, util.DirectionIn)
+		if got := status.Code(err); got != c.want {
+			t.Errorf("Fetch() with import groups of token %v got code %v, want %v",
+				c.token, got, c.want)
+		}
+	}
+}
+
+// cityMemDb loads a memdb with three cities contained in geoId/17.
+func cityMemDb(t *testing.T) *memdb.MemDb {
+	ctx := context.Background()
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"memdb.json": `{"rootSvg": "g/Private"}`,
+		"city.tmcf": `Node: E:city->E0
+typeOf: dcs:City
+dcid: C:city->Dcid
+containedInPlace: dcid:geoId/17
+`,
+		"city.csv": "Dcid\nwikidataId/Q1\nwikidataId/Q2\nwikidataId/Q3\n",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	memDb := memdb.NewMemDb()
+	if err := memDb.LoadConfig(ctx, filepath.Join(dir, "memdb.json")); err != nil {
+		t.Fatalf("LoadConfig() = %s", err)
+	}
+	if err := memDb.LoadFromDir(ctx, dir); err != nil {
+		t.Fatalf("LoadFromDir() = %s", err)
+	}
+	return memDb
+}
+
+func TestFetchMemDbPages(t *testing.T) {
+	ctx := context.Background()
+	memDb := cityMemDb(t)
+	st := store.NewStore(nil, memDb, []*bigtable.Table{
+		bigtable.NewTableWithBackend("frequent_2022_02", bigtable.NewLocalBackend(nil)),
+	}, "")
+
+	got := []string{}
+	token := ""
+	for page := 0; page < 3; page++ {
+		data, pi, err := Fetch(ctx, st, []string{"containedInPlace"}, []string{"geoId/17"},
+			2, token, util.DirectionIn)
+		if err != nil {
+			t.Fatalf("Fetch() page %d = %s", page, err)
+		}
+		values := data["containedInPlace"]["geoId/17"]
+		if len(values) > 2 {
+			t.Errorf("Fetch() page %d got %d values over the limit", page, len(values))
+		}
+		for _, v := range values {
+			got = append(got, v.Dcid)
+		}
+		if pi == nil {
+			break
+		}
+		if token, err = util.EncodeProto(pi); err != nil {
+			t.Fatalf("EncodeProto() = %s", err)
+		}
+	}
+	want := []string{"wikidataId/Q1", "wikidataId/Q2", "wikidataId/Q3"}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("Fetch() pages got diff %v", diff)
+	}
+}
+
+func TestMergeMemDbAfterBigtable(t *testing.T) {
+	// Three values in memdb, one of them also in Bigtable.
+	memDb := cityMemDb(t)
+	p, e := "containedInPlace", "geoId/17"
+	dcids := func(data map[string]map[string][]*pb.EntityInfo) []string {
+		result := []string{}
+		for _, v := range data[p][e] {
+			result = append(result, v.Dcid)
+		}
+		return result
+	}
+	btPage := &pb.PaginationInfo{
+		CursorGroups: []*pb.CursorGroup{{
+			Keys:    []string{e, p},
+			Cursors: []*pb.Cursor{{Page: 1}},
+		}},
+	}
+
+	// A full page of Bigtable values keeps the memdb values for later pages.
+	data := map[string]map[string][]*pb.EntityInfo{
+		p: {e: {{Dcid: "geoId/1"}, {Dcid: "geoId/2"}}},
+	}
+	pi := mergeMemDb(memDb, []string{p}, []string{e}, util.DirectionIn, 2,
+		nil, data, btPage)
+	if diff := cmp.Diff(dcids(data), []string{"geoId/1", "geoId/2"}); diff != "" {
+		t.Errorf("mergeMemDb() with full page got diff %v", diff)
+	}
+	if pi != btPage || pi.CursorGroups[0].MemdbItem != 0 {
+		t.Errorf("mergeMemDb() with full page got pagination %v", pi)
+	}
+
+	// Memdb values fill the page after the last Bigtable values, skipping the
+	// duplicates.
+	data = map[string]map[string][]*pb.EntityInfo{
+		p: {e: {{Dcid: "wikidataId/Q1"}}},
+	}
+	pi = mergeMemDb(memDb, []string{p}, []string{e}, util.DirectionIn, 2,
+		nil, data, nil)
+	if diff := cmp.Diff(dcids(data), []string{"wikidataId/Q1", "wikidataId/Q2"}); diff != "" {
+		t.Errorf("mergeMemDb() after Bigtable got diff %v", diff)
+	}
+	if pi == nil || pi.CursorGroups[0].MemdbItem != 2 || len(pi.CursorGroups[0].Cursors) > 0 {
+		t.Fatalf("mergeMemDb() after Bigtable got pagination %v", pi)
+	}
+
+	// The last memdb value ends the pages.
+	data = map[string]map[string][]*pb.EntityInfo{}
+	pi = mergeMemDb(memDb, []string{p}, []string{e}, util.DirectionIn, 2,
+		map[string]map[string]int32{p: {e: 2}}, data, nil)
+	if diff := cmp.Diff(dcids(data), []string{"wikidataId/Q3"}); diff != "" {
+		t.Errorf("mergeMemDb() for last page got diff %v", diff)
+	}
+	if pi != nil {
+		t.Errorf("mergeMemDb() for last page got pagination %v", pi)
+	}
+}
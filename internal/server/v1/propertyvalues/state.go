@@ -42,6 +42,8 @@ type state struct {
 	totalPage map[string]map[string]map[int]int
 	// Record the import group for next item to read
 	next map[string]map[string]*pb.Cursor
+	// Import groups of the tables that the cursors index into.
+	importGroups []string
 }
 
 type inState struct {
@@ -231,5 +233,5 @@ func (s *state) getPagination() *pb.PaginationInfo {
 			)
 		}
 	}
-	return &pb.PaginationInfo{CursorGroups: cursorGroups}
+	return &pb.PaginationInfo{CursorGroups: cursorGroups, ImportGroups: s.importGroups}
 }
@@ -0,0 +1,67 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigtable
+
+import (
+	"context"
+
+	cbt "cloud.google.com/go/bigtable"
+)
+
+// Backend is a key-value store that holds the cache of an import group.
+//
+// Values are the raw cache values, which are compressed and encoded the same
+// way as in Cloud Bigtable.
+type Backend interface {
+	// ReadRows reads the rows of the keys. Keys without a row are skipped. It
+	// stops when fn returns false.
+	ReadRows(ctx context.Context, keys []string, fn func(key string, value []byte) bool) error
+	// ReadPrefix reads all the rows with keys of a prefix. It stops when fn
+	// returns false.
+	ReadPrefix(ctx context.Context, prefix string, fn func(key string, value []byte) bool) error
+}
+
+// cloudBackend reads the cache from a Cloud Bigtable table.
+type cloudBackend struct {
+	table *cbt.Table
+}
+
+// NewCloudBackend creates a backend of a Cloud Bigtable table.
+func NewCloudBackend(table *cbt.Table) Backend {
+	return &cloudBackend{table: table}
+}
+
+func (b *cloudBackend) readRows(
+	ctx context.Context, rowSet cbt.RowSet, fn func(key string, value []byte) bool,
+) error {
+	return b.table.ReadRows(ctx, rowSet, func(btRow cbt.Row) bool {
+		if len(btRow[BtFamily]) == 0 {
+			return true
+		}
+		return fn(btRow.Key(), btRow[BtFamily][0].Value)
+	})
+}
+
+func (b *cloudBackend) ReadRows(
+	ctx context.Context, keys []string, fn func(key string, value []byte) bool,
+) error {
+	return b.readRows(ctx, cbt.RowList(keys), fn)
+}
+
+func (b *cloudBackend) ReadPrefix(
+	ctx context.Context, prefix string, fn func(key string, value []byte) bool,
+) error {
+	return b.readRows(ctx, cbt.PrefixRange(prefix), fn)
+}
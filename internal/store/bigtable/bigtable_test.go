@@ -31,9 +31,9 @@ func TestReadOneTable(t *testing.T) {
 	if err != nil {
 		t.Errorf("setupBigtable got error: %v", err)
 	}
-	btData, err := Read(
+	btData, _, err := Read(
 		ctx,
-		NewGroup([]*Table{{name: "test", table: btTable}}, ""),
+		NewGroup([]*Table{{name: "test", backend: NewCloudBackend(btTable)}}, ""),
 		"dc/1/",
 		[][]string{{"key1", "key2"}},
 		func(jsonRaw []byte) (interface{}, error) {
@@ -74,12 +74,12 @@ func TestReadTwoTables(t *testing.T) {
 		t.Errorf("setupBigtable2 got error: %v", err)
 	}
 
-	dataList, err := Read(
+	dataList, names, err := Read(
 		ctx,
 		NewGroup(
 			[]*Table{
-				{name: "t1_t", table: btTable1},
-				{name: "t2_t", table: btTable2},
+				{name: "t1_t", backend: NewCloudBackend(btTable1)},
+				{name: "t2_t", backend: NewCloudBackend(btTable2)},
 			},
 			"t2",
 		),
@@ -92,6 +92,9 @@ func TestReadTwoTables(t *testing.T) {
 	if err != nil {
 		t.Errorf("btReadRowsParallel got error: %v", err)
 	}
+	if diff := cmp.Diff(names, []string{"t1_t", "t2_t"}); diff != "" {
+		t.Errorf("read table names got diff %+v", diff)
+	}
 	for _, row := range dataList[0] {
 		dcid := row.Parts[0]
 		if diff := cmp.Diff(data1[dcid], row.Data.(string)); diff != "" {
@@ -99,3 +102,21 @@ func TestReadTwoTables(t *testing.T) {
 		}
 	}
 }
+
+func TestReadNilTable(t *testing.T) {
+	btData, _, err := Read(
+		context.Background(),
+		NewGroup([]*Table{NewTable("frequent_2022", nil)}, ""),
+		"dc/1/",
+		[][]string{{"key1"}},
+		func(jsonRaw []byte) (interface{}, error) {
+			return string(jsonRaw), nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("Read() = %s", err)
+	}
+	if len(btData[0]) != 0 {
+		t.Errorf("Read() of a nil table got rows %v", btData[0])
+	}
+}
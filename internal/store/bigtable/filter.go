@@ -0,0 +1,102 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigtable
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+type importGroupFilterKey struct{}
+
+// ImportGroupFilter selects the tables that the reads of a request use, and
+// records the tables that have data for the request.
+//
+// Import groups are given by the group name, like "ipcc" or "dcbranch", or by
+// the full table name.
+type ImportGroupFilter struct {
+	// Only read these import groups when not empty.
+	Allow []string
+	// Never read these import groups.
+	Deny []string
+
+	lock sync.Mutex
+	used map[string]struct{}
+}
+
+// WithImportGroupFilter returns a context whose reads use the filter.
+func WithImportGroupFilter(ctx context.Context, f *ImportGroupFilter) context.Context {
+	return context.WithValue(ctx, importGroupFilterKey{}, f)
+}
+
+func importGroupFilterFrom(ctx context.Context) *ImportGroupFilter {
+	f, _ := ctx.Value(importGroupFilterKey{}).(*ImportGroupFilter)
+	return f
+}
+
+// FiltersImportGroups checks whether the reads of a context skip any import
+// group, so their results differ from the ones of unfiltered reads.
+func FiltersImportGroups(ctx context.Context) bool {
+	f := importGroupFilterFrom(ctx)
+	return f != nil && (len(f.Allow) > 0 || len(f.Deny) > 0)
+}
+
+func matchImportGroup(tableName string, names []string) bool {
+	group := ImportGroupName(tableName)
+	for _, name := range names {
+		if name == tableName || name == group {
+			return true
+		}
+	}
+	return false
+}
+
+// allows checks whether a table can be read. A nil filter allows all tables.
+func (f *ImportGroupFilter) allows(tableName string) bool {
+	if f == nil {
+		return true
+	}
+	if len(f.Allow) > 0 && !matchImportGroup(tableName, f.Allow) {
+		return false
+	}
+	return !matchImportGroup(tableName, f.Deny)
+}
+
+// markUsed records that a table has data for the request.
+func (f *ImportGroupFilter) markUsed(tableName string) {
+	if f == nil {
+		return
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.used == nil {
+		f.used = map[string]struct{}{}
+	}
+	f.used[tableName] = struct{}{}
+}
+
+// UsedTables gets the sorted names of the tables that have data for the
+// request.
+func (f *ImportGroupFilter) UsedTables() []string {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	result := []string{}
+	for name := range f.used {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,86 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigtable
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestImportGroupFilter(t *testing.T) {
+	group := NewGroup([]*Table{
+		NewTableWithBackend("dcbranch_2022_01", NewLocalBackend(map[string][]byte{
+			"d/1/key1": encode(t, "branch1"),
+		})),
+		NewTableWithBackend("frequent_2022_01", NewLocalBackend(map[string][]byte{
+			"d/1/key1": encode(t, "frequent1"),
+			"d/1/key2": encode(t, "frequent2"),
+		})),
+		NewTableWithBackend("ipcc_2022_01", NewLocalBackend(map[string][]byte{
+			"d/1/key2": encode(t, "ipcc2"),
+		})),
+	}, "dcbranch_2022_01")
+	unmarshal := func(jsonRaw []byte) (interface{}, error) {
+		return string(jsonRaw), nil
+	}
+
+	for _, c := range []struct {
+		filter   *ImportGroupFilter
+		wantRows []int
+		wantUsed []string
+	}{
+		{
+			&ImportGroupFilter{},
+			[]int{1, 2, 1},
+			[]string{"dcbranch_2022_01", "frequent_2022_01", "ipcc_2022_01"},
+		},
+		{
+			&ImportGroupFilter{Allow: []string{"ipcc"}},
+			[]int{0, 0, 1},
+			[]string{"ipcc_2022_01"},
+		},
+		{
+			&ImportGroupFilter{Deny: []string{"dcbranch"}},
+			[]int{0, 2, 1},
+			[]string{"frequent_2022_01", "ipcc_2022_01"},
+		},
+		{
+			&ImportGroupFilter{
+				Allow: []string{"frequent_2022_01", "ipcc"},
+				Deny:  []string{"ipcc_2022_01"},
+			},
+			[]int{0, 2, 0},
+			[]string{"frequent_2022_01"},
+		},
+	} {
+		ctx := WithImportGroupFilter(context.Background(), c.filter)
+		dataList, _, err := Read(ctx, group, "d/1/", [][]string{{"key1", "key2"}}, unmarshal)
+		if err != nil {
+			t.Fatalf("Read() = %s", err)
+		}
+		gotRows := []int{}
+		for _, rows := range dataList {
+			gotRows = append(gotRows, len(rows))
+		}
+		if diff := cmp.Diff(gotRows, c.wantRows); diff != "" {
+			t.Errorf("Read() with %+v got rows diff %v", c.filter, diff)
+		}
+		if diff := cmp.Diff(c.filter.UsedTables(), c.wantUsed); diff != "" {
+			t.Errorf("UsedTables() with %+v got diff %v", c.filter, diff)
+		}
+	}
+}
@@ -18,6 +18,7 @@ package bigtable
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"strings"
 	"sync"
@@ -25,6 +26,8 @@ import (
 	cbt "cloud.google.com/go/bigtable"
 )
 
+// groupRank is the default rank of the import groups, where lower value ranks
+// higher. The rank in use can be changed with SetGroupRank.
 var groupRank = map[string]int{
 	"dcbranch":   0, // Used for the latest proto branch cache
 	"branch":     0, // Used for legacy branch cache
@@ -35,19 +38,60 @@ var groupRank = map[string]int{
 	"infrequent": 10000,
 }
 
+var (
+	currentGroupRank     = groupRank
+	currentGroupRankLock sync.RWMutex
+)
+
+// GroupRank gets a copy of the import group rank in use.
+func GroupRank() map[string]int {
+	currentGroupRankLock.RLock()
+	defer currentGroupRankLock.RUnlock()
+	result := map[string]int{}
+	for k, v := range currentGroupRank {
+		result[k] = v
+	}
+	return result
+}
+
+// SetGroupRank sets the import group rank in use over the default rank, so the
+// groups not in rank keep their default rank. A nil rank restores the default
+// rank. Existing groups need to be sorted again with Group.Sort.
+func SetGroupRank(rank map[string]int) {
+	merged := map[string]int{}
+	for k, v := range groupRank {
+		merged[k] = v
+	}
+	for k, v := range rank {
+		merged[k] = v
+	}
+	currentGroupRankLock.Lock()
+	defer currentGroupRankLock.Unlock()
+	currentGroupRank = merged
+}
+
 // Import group with unspecified rank should be ranked right before the
 // infrequent group and after all other groups.
 const defaultRank = 9999
 
-// Table holds the bigtable name and client stub.
+// Table holds the bigtable name and the backend to read it.
 type Table struct {
-	name  string
-	table *cbt.Table
+	name    string
+	backend Backend
 }
 
-// NewTable creates a new Table struct.
+// NewTable creates a new Table struct of a Cloud Bigtable table. A nil table
+// has no backend, so it is skipped by reads.
 func NewTable(name string, table *cbt.Table) *Table {
-	return &Table{name: name, table: table}
+	if table == nil {
+		return &Table{name: name}
+	}
+	return &Table{name: name, backend: NewCloudBackend(table)}
+}
+
+// NewTableWithBackend creates a new Table struct read from a backend.
+func NewTableWithBackend(name string, backend Backend) *Table {
+	return &Table{name: name, backend: backend}
 }
 
 // Name access the name of a table
@@ -86,17 +130,36 @@ func GetFrequentGroup(g *Group) *Group {
 	return result
 }
 
-// Tables is the accessor for all the Bigtable client stubs.
-func (g *Group) Tables() []*cbt.Table {
+// Tables is the accessor for the backends of all the tables.
+func (g *Group) Tables() []Backend {
 	g.lock.RLock()
 	defer g.lock.RUnlock()
-	result := []*cbt.Table{}
+	result := []Backend{}
 	for _, t := range g.tables {
-		result = append(result, t.table)
+		result = append(result, t.backend)
 	}
 	return result
 }
 
+// tableList gets a copy of the tables, to read the tables and their names
+// consistently.
+func (g *Group) tableList() []*Table {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	return append([]*Table{}, g.tables...)
+}
+
+// Copy gets a group of the current tables, for requests that read the tables
+// several times and need them to stay the same across table updates.
+func (g *Group) Copy() *Group {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	return &Group{
+		tables:          append([]*Table{}, g.tables...),
+		branchTableName: g.branchTableName,
+	}
+}
+
 // TableNames is the accesser to get all the Bigtable names.
 func (g *Group) TableNames() []string {
 	g.lock.RLock()
@@ -108,6 +171,63 @@ func (g *Group) TableNames() []string {
 	return result
 }
 
+// probePrefix is the key prefix of the cache rows, which every table has.
+const probePrefix = "d/"
+
+// Probe reads the first cache row of every table, to check that the tables
+// are reachable and have cache data. A table without cache rows fails.
+func (g *Group) Probe(ctx context.Context) error {
+	for _, t := range g.tableList() {
+		found := false
+		err := t.backend.ReadPrefix(ctx, probePrefix, func(string, []byte) bool {
+			found = true
+			return false
+		})
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("table %s has no cache rows", t.name)
+		}
+	}
+	return nil
+}
+
+// ImportGroupTables gets all the tables except the branch table.
+func (g *Group) ImportGroupTables() []*Table {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+	result := []*Table{}
+	for _, t := range g.tables {
+		if t.name != g.branchTableName {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// UpdateImportGroupTables atomically replaces all the tables except the branch
+// table.
+func (g *Group) UpdateImportGroupTables(tables []*Table) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	result := append([]*Table{}, tables...)
+	for _, t := range g.tables {
+		if t.name == g.branchTableName {
+			result = append(result, t)
+		}
+	}
+	g.tables = result
+	SortTables(g.tables)
+}
+
+// Sort sorts the tables again, after the import group rank changes.
+func (g *Group) Sort() {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	SortTables(g.tables)
+}
+
 // UpdateBranchTable updates the branch Bigtable.
 func (g *Group) UpdateBranchTable(branchTable *Table) {
 	g.lock.Lock()
@@ -124,6 +244,12 @@ func (g *Group) UpdateBranchTable(branchTable *Table) {
 	SortTables(g.tables)
 }
 
+// ImportGroupName gets the import group of a table from the table name, like
+// "frequent" of "frequent_2022_02_01_14_20_47".
+func ImportGroupName(tableName string) string {
+	return strings.Split(tableName, "_")[0]
+}
+
 // NewTable creates a new cbt.Table instance.
 func NewBtTable(ctx context.Context, projectID, instanceID, tableID string) (
 	*cbt.Table, error) {
@@ -140,16 +266,15 @@ func NewBtTable(ctx context.Context, projectID, instanceID, tableID string) (
 // - if a group is not in ranking list, put it right before "infrequent" and
 //   after other groups with ranking.
 func SortTables(tables []*Table) {
+	groupRank := GroupRank()
 	sort.SliceStable(tables, func(i, j int) bool {
-		// This is to parse the table name like "frequent_2022_02_01_14_20_47"
-		// and get the actual import group name.
-		ni := strings.Split(tables[i].name, "_")[0]
+		ni := ImportGroupName(tables[i].name)
 		ri, ok := groupRank[ni]
 		if !ok {
 			ri = defaultRank
 		}
 		// ranking for j
-		nj := strings.Split(tables[j].name, "_")[0]
+		nj := ImportGroupName(tables[j].name)
 		rj, ok := groupRank[nj]
 		if !ok {
 			rj = defaultRank
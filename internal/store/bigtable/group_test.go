@@ -28,28 +28,28 @@ func TestSortTables(t *testing.T) {
 	}{
 		{
 			tables: []*Table{
-				{name: "random_2022", table: nil},
-				{name: "infrequent_2022_01_31_23_15_14", table: nil},
-				{name: "frequent_2022_02_01_14_20_47", table: nil},
-				{name: "dcbranch_2022_02_01_14_00_49", table: nil},
-				{name: "ipcc_2022_01_31_20_56_49", table: nil},
+				{name: "random_2022", backend: nil},
+				{name: "infrequent_2022_01_31_23_15_14", backend: nil},
+				{name: "frequent_2022_02_01_14_20_47", backend: nil},
+				{name: "dcbranch_2022_02_01_14_00_49", backend: nil},
+				{name: "ipcc_2022_01_31_20_56_49", backend: nil},
 			},
 			expected: []*Table{
-				{name: "dcbranch_2022_02_01_14_00_49", table: nil},
-				{name: "frequent_2022_02_01_14_20_47", table: nil},
-				{name: "ipcc_2022_01_31_20_56_49", table: nil},
-				{name: "random_2022", table: nil},
-				{name: "infrequent_2022_01_31_23_15_14", table: nil},
+				{name: "dcbranch_2022_02_01_14_00_49", backend: nil},
+				{name: "frequent_2022_02_01_14_20_47", backend: nil},
+				{name: "ipcc_2022_01_31_20_56_49", backend: nil},
+				{name: "random_2022", backend: nil},
+				{name: "infrequent_2022_01_31_23_15_14", backend: nil},
 			},
 		},
 		{
 			tables: []*Table{
-				{name: "borgcron_2022_02_15_01_02_51", table: nil},
-				{name: "branch_dcbranch_2022_02_16_14_18_02", table: nil},
+				{name: "borgcron_2022_02_15_01_02_51", backend: nil},
+				{name: "branch_dcbranch_2022_02_16_14_18_02", backend: nil},
 			},
 			expected: []*Table{
-				{name: "branch_dcbranch_2022_02_16_14_18_02", table: nil},
-				{name: "borgcron_2022_02_15_01_02_51", table: nil},
+				{name: "branch_dcbranch_2022_02_16_14_18_02", backend: nil},
+				{name: "borgcron_2022_02_15_01_02_51", backend: nil},
 			},
 		},
 	} {
@@ -67,3 +67,49 @@ func TestSortTables(t *testing.T) {
 		}
 	}
 }
+
+func TestSetGroupRank(t *testing.T) {
+	defer SetGroupRank(nil)
+	// Only ipcc is ranked, the other groups keep their default rank.
+	SetGroupRank(map[string]int{"ipcc": 5})
+	tables := []*Table{
+		{name: "infrequent_2022_01_31_23_15_14", backend: nil},
+		{name: "ipcc_2022_01_31_20_56_49", backend: nil},
+		{name: "random_2022", backend: nil},
+		{name: "frequent_2022_02_01_14_20_47", backend: nil},
+		{name: "dcbranch_2022_02_01_14_00_49", backend: nil},
+	}
+	SortTables(tables)
+	tableNames := []string{}
+	for _, t := range tables {
+		tableNames = append(tableNames, t.name)
+	}
+	expected := []string{
+		"dcbranch_2022_02_01_14_00_49",
+		"frequent_2022_02_01_14_20_47",
+		"ipcc_2022_01_31_20_56_49",
+		"random_2022",
+		"infrequent_2022_01_31_23_15_14",
+	}
+	if diff := cmp.Diff(tableNames, expected); diff != "" {
+		t.Errorf("SortTables() got diff: %v", diff)
+	}
+	if got := GroupRank()["ipcc"]; got != 5 {
+		t.Errorf("GroupRank()[ipcc] = %d, want 5", got)
+	}
+}
+
+func TestGroupCopy(t *testing.T) {
+	group := NewGroup([]*Table{
+		{name: "frequent_2022_02_01_14_20_47", backend: nil},
+		{name: "dcbranch_2022_02_01_14_00_49", backend: nil},
+	}, "dcbranch_2022_02_01_14_00_49")
+	copied := group.Copy()
+	group.UpdateImportGroupTables([]*Table{
+		{name: "frequent_2022_03_01_14_20_47", backend: nil},
+	})
+	expected := []string{"dcbranch_2022_02_01_14_00_49", "frequent_2022_02_01_14_20_47"}
+	if diff := cmp.Diff(copied.TableNames(), expected); diff != "" {
+		t.Errorf("Copy() tables changed after update, got diff: %v", diff)
+	}
+}
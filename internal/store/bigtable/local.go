@@ -0,0 +1,148 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigtable
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// LocalFileSuffix is the file name suffix of the local cache files. The file
+// name without the suffix is the table name.
+const LocalFileSuffix = ".kv"
+
+// LocalBackend is an in-memory backend loaded from a local file, for running
+// mixer without Cloud Bigtable.
+//
+// The file has one row per line, with the key and the raw cache value
+// separated by a tab. Raw cache values are base64 encoded, so they have no
+// tab or new line.
+type LocalBackend struct {
+	// Sorted keys, for prefix reads.
+	keys []string
+	rows map[string][]byte
+}
+
+// NewLocalBackend creates a local backend with the rows.
+func NewLocalBackend(rows map[string][]byte) *LocalBackend {
+	keys := make([]string, 0, len(rows))
+	for key := range rows {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return &LocalBackend{keys: keys, rows: rows}
+}
+
+// ReadLocalBackend reads a local backend from a reader in the local file
+// format.
+func ReadLocalBackend(r io.Reader) (*LocalBackend, error) {
+	rows := map[string][]byte{}
+	scanner := bufio.NewScanner(r)
+	// Cache values can be large.
+	scanner.Buffer(make([]byte, 1024*1024), 256*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+		if text == "" {
+			continue
+		}
+		parts := strings.SplitN(text, "\t", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid row at line %d, want <key>\\t<value>", line)
+		}
+		rows[parts[0]] = []byte(parts[1])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return NewLocalBackend(rows), nil
+}
+
+// WriteLocalBackend writes rows in the local file format, sorted by key.
+func WriteLocalBackend(w io.Writer, rows map[string][]byte) error {
+	b := NewLocalBackend(rows)
+	bw := bufio.NewWriter(w)
+	for _, key := range b.keys {
+		if _, err := fmt.Fprintf(bw, "%s\t%s\n", key, b.rows[key]); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// LoadLocalTables loads a table from each local cache file in a directory.
+func LoadLocalTables(dir string) ([]*Table, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*"+LocalFileSuffix))
+	if err != nil {
+		return nil, err
+	}
+	tables := []*Table{}
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		backend, err := ReadLocalBackend(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %v", file, err)
+		}
+		name := strings.TrimSuffix(filepath.Base(file), LocalFileSuffix)
+		tables = append(tables, NewTableWithBackend(name, backend))
+	}
+	return tables, nil
+}
+
+// ReadRows implements Backend.ReadRows.
+func (b *LocalBackend) ReadRows(
+	ctx context.Context, keys []string, fn func(key string, value []byte) bool,
+) error {
+	for _, key := range keys {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		value, ok := b.rows[key]
+		if !ok {
+			continue
+		}
+		if !fn(key, value) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// ReadPrefix implements Backend.ReadPrefix.
+func (b *LocalBackend) ReadPrefix(
+	ctx context.Context, prefix string, fn func(key string, value []byte) bool,
+) error {
+	i := sort.SearchStrings(b.keys, prefix)
+	for ; i < len(b.keys) && strings.HasPrefix(b.keys[i], prefix); i++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !fn(b.keys[i], b.rows[b.keys[i]]) {
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigtable
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/datacommonsorg/mixer/internal/util"
+	"github.com/google/go-cmp/cmp"
+)
+
+func encode(t *testing.T, data string) []byte {
+	raw, err := util.ZipAndEncode([]byte(data))
+	if err != nil {
+		t.Fatalf("ZipAndEncode(%s) = %s", data, err)
+	}
+	return []byte(raw)
+}
+
+func TestLocalBackend(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	for name, rows := range map[string]map[string][]byte{
+		"frequent_2022": {
+			"d/1/key1": encode(t, "foo1"),
+			"d/1/key2": encode(t, "foo2"),
+		},
+		"infrequent_2022": {
+			"d/1/key1": encode(t, "bar1"),
+			"d/2/key3": encode(t, "bar3"),
+		},
+	} {
+		var buf bytes.Buffer
+		if err := WriteLocalBackend(&buf, rows); err != nil {
+			t.Fatalf("WriteLocalBackend() = %s", err)
+		}
+		if err := os.WriteFile(
+			filepath.Join(dir, name+LocalFileSuffix), buf.Bytes(), 0644); err != nil {
+			t.Fatalf("WriteFile() = %s", err)
+		}
+	}
+	tables, err := LoadLocalTables(dir)
+	if err != nil {
+		t.Fatalf("LoadLocalTables() = %s", err)
+	}
+	group := NewGroup(tables, "")
+	if diff := cmp.Diff(group.TableNames(), []string{"frequent_2022", "infrequent_2022"}); diff != "" {
+		t.Errorf("TableNames() got diff %v", diff)
+	}
+
+	unmarshal := func(jsonRaw []byte) (interface{}, error) {
+		return string(jsonRaw), nil
+	}
+	toMap := func(dataList [][]BtRow) []map[string]string {
+		result := []map[string]string{}
+		for _, rows := range dataList {
+			m := map[string]string{}
+			for _, row := range rows {
+				m[row.Parts[0]] = row.Data.(string)
+			}
+			result = append(result, m)
+		}
+		return result
+	}
+
+	dataList, names, err := Read(ctx, group, "d/1/", [][]string{{"key1", "key2", "key3"}}, unmarshal)
+	if err != nil {
+		t.Fatalf("Read() = %s", err)
+	}
+	wantNames := []string{"frequent_2022", "infrequent_2022"}
+	if diff := cmp.Diff(names, wantNames); diff != "" {
+		t.Errorf("Read() got table names diff %v", diff)
+	}
+	want := []map[string]string{
+		{"key1": "foo1", "key2": "foo2"},
+		{"key1": "bar1"},
+	}
+	if diff := cmp.Diff(toMap(dataList), want); diff != "" {
+		t.Errorf("Read() got diff %v", diff)
+	}
+
+	dataList, names, err = ReadPrefix(ctx, group, "d/2/", unmarshal)
+	if err != nil {
+		t.Fatalf("ReadPrefix() = %s", err)
+	}
+	if diff := cmp.Diff(names, wantNames); diff != "" {
+		t.Errorf("ReadPrefix() got table names diff %v", diff)
+	}
+	want = []map[string]string{{}, {"key3": "bar3"}}
+	if diff := cmp.Diff(toMap(dataList), want); diff != "" {
+		t.Errorf("ReadPrefix() got diff %v", diff)
+	}
+}
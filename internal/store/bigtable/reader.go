@@ -35,34 +35,45 @@ type BtRow struct {
 	Data interface{}
 }
 
+// parseRow decodes the raw value of a row, and splits the key after the prefix
+// into the body parts.
+func parseRow(
+	key string,
+	raw []byte,
+	prefix string,
+	action func([]byte) (interface{}, error),
+) (BtRow, error) {
+	jsonRaw, err := util.UnzipAndDecode(string(raw))
+	if err != nil {
+		return BtRow{}, err
+	}
+	elem, err := action(jsonRaw)
+	if err != nil {
+		return BtRow{}, err
+	}
+	parts := strings.Split(strings.TrimPrefix(key, prefix), "^")
+	return BtRow{parts, elem}, nil
+}
+
 // readRowFn generates a function to be used as the callback function in Bigtable Read.
 // This utilizes the Golang closure so the arguments can be scoped in the
 // generated function.
 func readRowFn(
 	errCtx context.Context,
-	btTable *cbt.Table,
-	rowSetPart cbt.RowSet,
+	btTable Backend,
+	rowSetPart cbt.RowList,
 	action func([]byte) (interface{}, error),
 	btRowChan chan BtRow,
 	prefix string,
 ) func() error {
 	return func() error {
 		if err := btTable.ReadRows(errCtx, rowSetPart,
-			func(btRow cbt.Row) bool {
-				if len(btRow[BtFamily]) == 0 {
-					return true
-				}
-				raw := btRow[BtFamily][0].Value
-				jsonRaw, err := util.UnzipAndDecode(string(raw))
-				if err != nil {
-					return false
-				}
-				elem, err := action(jsonRaw)
+			func(key string, raw []byte) bool {
+				btRow, err := parseRow(key, raw, prefix, action)
 				if err != nil {
 					return false
 				}
-				parts := strings.Split(strings.TrimPrefix(btRow.Key(), prefix), "^")
-				btRowChan <- BtRow{parts, elem}
+				btRowChan <- btRow
 				return true
 			}); err != nil {
 			return err
@@ -73,18 +84,25 @@ func readRowFn(
 
 // Read reads BigTable rows from multiple Bigtable in parallel.
 // Note all Bigtable read use the same set of rowList.
+//
+// It also returns the names of the tables read, in the same order as the rows.
 func Read(
 	ctx context.Context,
 	btGroup *Group,
 	prefix string,
 	body [][]string,
 	action func([]byte) (interface{}, error),
-) ([][]BtRow, error) {
+) ([][]BtRow, []string, error) {
+	tables := btGroup.tableList()
 	accs := []*Accessor{}
-	for i := 0; i < len(btGroup.Tables()); i++ {
+	for i := 0; i < len(tables); i++ {
 		accs = append(accs, &Accessor{i, body})
 	}
-	return ReadWithGroupRowList(ctx, btGroup, prefix, accs, action)
+	result, err := readTables(ctx, tables, prefix, accs, action)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, tableNames(tables), nil
 }
 
 // ReadWithGroupRowList reads BigTable rows from multiple Bigtable in parallel.
@@ -99,7 +117,17 @@ func ReadWithGroupRowList(
 	accs []*Accessor,
 	unmarshalFunc func([]byte) (interface{}, error),
 ) ([][]BtRow, error) {
-	tables := btGroup.Tables()
+	return readTables(ctx, btGroup.tableList(), prefix, accs, unmarshalFunc)
+}
+
+// readTables reads BigTable rows from a snapshot of the tables of a group.
+func readTables(
+	ctx context.Context,
+	tables []*Table,
+	prefix string,
+	accs []*Accessor,
+	unmarshalFunc func([]byte) (interface{}, error),
+) ([][]BtRow, error) {
 	if len(tables) == 0 {
 		return nil, status.Errorf(codes.NotFound, "Bigtable instance is not specified")
 	}
@@ -132,8 +160,9 @@ func ReadWithGroupRowList(
 				right = rowSetSize
 			}
 			rowSetPart := rowSet[left:right]
-			if tables[i] != nil {
-				errs.Go(readRowFn(errCtx, tables[i], rowSetPart, unmarshalFunc, chans[i], prefix))
+			if readable(ctx, tables[i]) {
+				errs.Go(readRowFn(
+					errCtx, tables[i].backend, rowSetPart, unmarshalFunc, chans[i], prefix))
 			}
 		}
 	}
@@ -151,8 +180,75 @@ func ReadWithGroupRowList(
 			for elem := range chans[i] {
 				items = append(items, elem)
 			}
+			if len(items) > 0 {
+				importGroupFilterFrom(ctx).markUsed(tables[i].name)
+			}
 			result = append(result, items)
 		}
 	}
 	return result, nil
 }
+
+// ReadPrefix reads all the BigTable rows with a key prefix from multiple
+// Bigtable in parallel.
+//
+// It also returns the names of the tables read, in the same order as the rows.
+func ReadPrefix(
+	ctx context.Context,
+	btGroup *Group,
+	prefix string,
+	action func([]byte) (interface{}, error),
+) ([][]BtRow, []string, error) {
+	tables := btGroup.tableList()
+	if len(tables) == 0 {
+		return nil, nil, status.Errorf(codes.NotFound, "Bigtable instance is not specified")
+	}
+	result := make([][]BtRow, len(tables))
+	errs, errCtx := errgroup.WithContext(ctx)
+	for i := 0; i < len(tables); i++ {
+		i := i
+		if !readable(ctx, tables[i]) {
+			continue
+		}
+		errs.Go(func() error {
+			var readErr error
+			err := tables[i].backend.ReadPrefix(errCtx, prefix,
+				func(key string, raw []byte) bool {
+					btRow, err := parseRow(key, raw, prefix, action)
+					if err != nil {
+						readErr = err
+						return false
+					}
+					result[i] = append(result[i], btRow)
+					return true
+				})
+			if err != nil {
+				return err
+			}
+			return readErr
+		})
+	}
+	if err := errs.Wait(); err != nil {
+		return nil, nil, err
+	}
+	for i, rows := range result {
+		if len(rows) > 0 {
+			importGroupFilterFrom(ctx).markUsed(tables[i].name)
+		}
+	}
+	return result, tableNames(tables), nil
+}
+
+func tableNames(tables []*Table) []string {
+	result := []string{}
+	for _, t := range tables {
+		result = append(result, t.name)
+	}
+	return result
+}
+
+// readable checks whether a table can be read by the import group filter of
+// the context.
+func readable(ctx context.Context, t *Table) bool {
+	return t.backend != nil && importGroupFilterFrom(ctx).allows(t.name)
+}
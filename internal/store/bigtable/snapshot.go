@@ -0,0 +1,107 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigtable
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// A snapshot holds the rows of some key prefixes from all the tables of a
+// group, to run mixer offline.
+//
+// It is a gzip file with one row per line, as the table name, the key and the
+// raw cache value separated by tabs. Tables are in rank order.
+
+// ExportSnapshot writes the rows of the key prefixes in all the tables of a
+// group as a snapshot. It returns the number of rows written.
+func ExportSnapshot(
+	ctx context.Context, group *Group, prefixes []string, w io.Writer,
+) (int, error) {
+	tables := group.tableList()
+
+	zw := gzip.NewWriter(w)
+	bw := bufio.NewWriter(zw)
+	count := 0
+	for _, t := range tables {
+		if t.backend == nil {
+			continue
+		}
+		for _, prefix := range prefixes {
+			var writeErr error
+			err := t.backend.ReadPrefix(ctx, prefix, func(key string, value []byte) bool {
+				_, writeErr = fmt.Fprintf(bw, "%s\t%s\t%s\n", t.name, key, value)
+				count++
+				return writeErr == nil
+			})
+			if err != nil {
+				return count, err
+			}
+			if writeErr != nil {
+				return count, writeErr
+			}
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		return count, err
+	}
+	return count, zw.Close()
+}
+
+// LoadSnapshot reads the tables of a snapshot into local backends.
+func LoadSnapshot(r io.Reader) ([]*Table, error) {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	tableNames := []string{}
+	tableRows := map[string]map[string][]byte{}
+	scanner := bufio.NewScanner(zr)
+	// Cache values can be large.
+	scanner.Buffer(make([]byte, 1024*1024), 256*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+		if text == "" {
+			continue
+		}
+		parts := strings.SplitN(text, "\t", 3)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf(
+				"invalid snapshot row at line %d, want <table>\\t<key>\\t<value>", line)
+		}
+		rows, ok := tableRows[parts[0]]
+		if !ok {
+			rows = map[string][]byte{}
+			tableRows[parts[0]] = rows
+			tableNames = append(tableNames, parts[0])
+		}
+		rows[parts[1]] = []byte(parts[2])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	tables := []*Table{}
+	for _, name := range tableNames {
+		tables = append(tables, NewTableWithBackend(name, NewLocalBackend(tableRows[name])))
+	}
+	return tables, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bigtable
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSnapshot(t *testing.T) {
+	ctx := context.Background()
+	btTable, err := SetupBigtable(ctx, map[string]string{
+		"d/3/geoId/06^Count_Person": "series1",
+		"d/3/geoId/08^Count_Person": "series2",
+		"d/c/geoId/06^County":       "places",
+		"d/4/geoId/06":              "place page",
+	})
+	if err != nil {
+		t.Fatalf("SetupBigtable() = %s", err)
+	}
+	group := NewGroup([]*Table{
+		NewTable("frequent_2022", btTable),
+		NewTableWithBackend("infrequent_2022", NewLocalBackend(map[string][]byte{
+			"d/3/geoId/06^Count_Person": []byte("series3"),
+		})),
+	}, "")
+
+	var buf bytes.Buffer
+	count, err := ExportSnapshot(ctx, group, []string{"d/3/", "d/c/"}, &buf)
+	if err != nil {
+		t.Fatalf("ExportSnapshot() = %s", err)
+	}
+	if count != 4 {
+		t.Errorf("ExportSnapshot() wrote %d rows, want 4", count)
+	}
+	tables, err := LoadSnapshot(&buf)
+	if err != nil {
+		t.Fatalf("LoadSnapshot() = %s", err)
+	}
+
+	got := map[string]map[string]string{}
+	for _, table := range tables {
+		got[table.Name()] = map[string]string{}
+		err := table.backend.ReadPrefix(ctx, "", func(key string, value []byte) bool {
+			got[table.Name()][key] = string(value)
+			return true
+		})
+		if err != nil {
+			t.Fatalf("ReadPrefix() = %s", err)
+		}
+	}
+	want := map[string]map[string]string{
+		"frequent_2022": {
+			"d/3/geoId/06^Count_Person": "series1",
+			"d/3/geoId/08^Count_Person": "series2",
+			"d/c/geoId/06^County":       "places",
+		},
+		"infrequent_2022": {
+			"d/3/geoId/06^Count_Person": "series3",
+		},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("LoadSnapshot() got diff %v", diff)
+	}
+}
@@ -20,20 +20,19 @@ import (
 	"io"
 	"io/ioutil"
 	"log"
-	"path/filepath"
+	"path"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
 
-	"cloud.google.com/go/storage"
 	"github.com/datacommonsorg/mixer/internal/parser/tmcf"
 	pb "github.com/datacommonsorg/mixer/internal/proto"
 	"github.com/datacommonsorg/mixer/internal/util"
-	"google.golang.org/api/iterator"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 // MemDb holds imported data in memory.
@@ -43,7 +42,9 @@ type MemDb struct {
 	config     *pb.MemdbConfig
 	// place -> svg -> count
 	placeSvExistence map[string]map[string]int32
-	lock             sync.RWMutex
+	// Triples of the nodes other than observations.
+	triples *tripleStore
+	lock    sync.RWMutex
 }
 
 // NewMemDb initialize a MemDb instance.
@@ -52,6 +53,7 @@ func NewMemDb() *MemDb {
 		statSeries:       map[string]map[string][]*pb.Series{},
 		config:           &pb.MemdbConfig{},
 		placeSvExistence: map[string]map[string]int32{},
+		triples:          newTripleStore(),
 	}
 }
 
@@ -97,8 +99,9 @@ func (memDb *MemDb) ReadSeries(statVar, place string) []*pb.Series {
 
 // ReadPointValue reads one observation point.
 // If date is "", the latest observation is returned, otherwise, the observation
-// corresponding to the given date is returned.
-func (memDb *MemDb) ReadPointValue(statVar, place, date string) (
+// that matches the given date in the match mode is returned, with the actual
+// observation date.
+func (memDb *MemDb) ReadPointValue(statVar, place, date string, match pb.DateMatch) (
 	*pb.PointStat, *pb.StatMetadata,
 ) {
 	memDb.lock.RLock()
@@ -112,40 +115,52 @@ func (memDb *MemDb) ReadPointValue(statVar, place, date string) (
 		return nil, nil
 	}
 	if date != "" {
-		// For private import, pick from a random series. In most cases, there should
-		// be just one series.
-		for _, series := range seriesList {
-			if val, ok := series.Val[date]; ok {
-				return &pb.PointStat{
-					Date:  date,
-					Value: val,
-				}, series.Metadata
-			}
-		}
-	} else {
-		// Get the latest date from all series
-		latestDate := ""
-		var latestVal float64
-		var meta *pb.StatMetadata
-		for _, series := range seriesList {
-			for date, val := range series.Val {
-				if date > latestDate {
-					latestDate = date
-					latestVal = val
-					meta = series.Metadata
-				}
+		return MatchPointValue(seriesList, date, match)
+	}
+	// Get the latest date from all series
+	latestDate := ""
+	var latestVal float64
+	var meta *pb.StatMetadata
+	for _, series := range seriesList {
+		for date, val := range series.Val {
+			if date > latestDate {
+				latestDate = date
+				latestVal = val
+				meta = series.Metadata
 			}
 		}
-		if latestDate != "" {
-			return &pb.PointStat{
-				Date:  latestDate,
-				Value: latestVal,
-			}, meta
-		}
+	}
+	if latestDate != "" {
+		return &pb.PointStat{
+			Date:  latestDate,
+			Value: latestVal,
+		}, meta
 	}
 	return nil, nil
 }
 
+// MatchPointValue gets the observation of a list of series that matches the
+// given date in the match mode, with the actual observation date.
+//
+// An exact date match is picked from any series. Otherwise the dates of each
+// series are matched, and the closest one across series is picked.
+func MatchPointValue(seriesList []*pb.Series, date string, match pb.DateMatch) (
+	*pb.PointStat, *pb.StatMetadata,
+) {
+	vals := make([]map[string]float64, len(seriesList))
+	for i, series := range seriesList {
+		vals[i] = series.Val
+	}
+	i, d := util.MatchDateAcrossSeries(vals, date, match)
+	if i < 0 {
+		return nil, nil
+	}
+	return &pb.PointStat{
+		Date:  d,
+		Value: seriesList[i].Val[d],
+	}, seriesList[i].Metadata
+}
+
 // ReadStatDate reads observation date frequency for a given stat var.
 func (memDb *MemDb) ReadStatDate(statVar string) *pb.StatDateList {
 	memDb.lock.RLock()
@@ -178,6 +193,88 @@ func (memDb *MemDb) ReadStatDate(statVar string) *pb.StatDateList {
 	return result
 }
 
+// ReadStatVarSummary summarizes the series of a stat var by import. It
+// returns nil when the stat var has no data.
+func (memDb *MemDb) ReadStatVarSummary(statVar string) *pb.StatVarSummary {
+	memDb.lock.RLock()
+	defer memDb.lock.RUnlock()
+	placeData, ok := memDb.statSeries[statVar]
+	if !ok {
+		return nil
+	}
+	result := &pb.StatVarSummary{
+		ProvenanceSummary: map[string]*pb.StatVarSummary_ProvenanceSummary{},
+	}
+	// Import name -> metadata hash -> series summary
+	seriesSummary := map[string]map[uint32]*pb.StatVarSummary_SeriesSummary{}
+	for _, seriesList := range placeData {
+		for _, series := range seriesList {
+			if len(series.Val) == 0 {
+				continue
+			}
+			importName := series.Metadata.ImportName
+			provSummary, ok := result.ProvenanceSummary[importName]
+			if !ok {
+				provSummary = &pb.StatVarSummary_ProvenanceSummary{ImportName: importName}
+				result.ProvenanceSummary[importName] = provSummary
+				seriesSummary[importName] = map[uint32]*pb.StatVarSummary_SeriesSummary{}
+			}
+			metahash := util.GetMetadataHash(series.Metadata)
+			summary, ok := seriesSummary[importName][metahash]
+			if !ok {
+				summary = &pb.StatVarSummary_SeriesSummary{
+					SeriesKey: &pb.StatVarSummary_SeriesSummary_SeriesKey{
+						MeasurementMethod: series.Metadata.MeasurementMethod,
+						ObservationPeriod: series.Metadata.ObservationPeriod,
+						ScalingFactor:     series.Metadata.ScalingFactor,
+						Unit:              series.Metadata.Unit,
+					},
+				}
+				seriesSummary[importName][metahash] = summary
+				provSummary.SeriesSummary = append(provSummary.SeriesSummary, summary)
+			}
+			for date, v := range series.Val {
+				if summary.ObservationCount == 0 || date < summary.EarliestDate {
+					summary.EarliestDate = date
+				}
+				if summary.ObservationCount == 0 || date > summary.LatestDate {
+					summary.LatestDate = date
+				}
+				if summary.ObservationCount == 0 || v < summary.MinValue {
+					summary.MinValue = v
+				}
+				if summary.ObservationCount == 0 || v > summary.MaxValue {
+					summary.MaxValue = v
+				}
+				summary.ObservationCount++
+			}
+			summary.TimeSeriesCount++
+			provSummary.ObservationCount += float64(len(series.Val))
+			provSummary.TimeSeriesCount++
+		}
+	}
+	if len(result.ProvenanceSummary) == 0 {
+		return nil
+	}
+	for _, provSummary := range result.ProvenanceSummary {
+		sort.Slice(provSummary.SeriesSummary, func(i, j int) bool {
+			ki := provSummary.SeriesSummary[i].SeriesKey
+			kj := provSummary.SeriesSummary[j].SeriesKey
+			if ki.MeasurementMethod != kj.MeasurementMethod {
+				return ki.MeasurementMethod < kj.MeasurementMethod
+			}
+			if ki.ObservationPeriod != kj.ObservationPeriod {
+				return ki.ObservationPeriod < kj.ObservationPeriod
+			}
+			if ki.Unit != kj.Unit {
+				return ki.Unit < kj.Unit
+			}
+			return ki.ScalingFactor < kj.ScalingFactor
+		})
+	}
+	return result
+}
+
 // GetStatVars retrieves the stat vars from private import that have data for
 // the given places.
 func (memDb *MemDb) GetStatVars(places []string) ([]string, []string) {
@@ -229,6 +326,34 @@ func getParentSvg(svgMap map[string]*pb.StatVarGroupNode) map[string]string {
 	return parentSvg
 }
 
+// ManifestFile is the name of the optional manifest of an import, in the same
+// format as the memdb config.
+const ManifestFile = "memdb.json"
+
+// countDescendentStatVars sets the number of descendent stat vars of each stat
+// var group.
+func countDescendentStatVars(config *pb.MemdbConfig) {
+	parentSvg := getParentSvg(config.StatVarGroups)
+	allStatVars := map[string]struct{}{}
+	for _, data := range config.StatVarGroups {
+		data.DescendentStatVarCount = 0
+		for _, child := range data.ChildStatVars {
+			allStatVars[child.Id] = struct{}{}
+		}
+	}
+	for sv := range allStatVars {
+		curr := sv
+		for {
+			parent, ok := parentSvg[curr]
+			if !ok {
+				break
+			}
+			config.StatVarGroups[parent].DescendentStatVarCount++
+			curr = parent
+		}
+	}
+}
+
 // LoadConfig loads the memdb config from file.
 func (memDb *MemDb) LoadConfig(ctx context.Context, file string) error {
 	bytes, err := ioutil.ReadFile(file)
@@ -244,105 +369,273 @@ func (memDb *MemDb) LoadConfig(ctx context.Context, file string) error {
 		return status.Errorf(codes.Internal, "Manifest missing the root SVG:\n%v", &config)
 	}
 	memDb.config = &config
-	parentSvg := getParentSvg(config.StatVarGroups)
-	allStatVars := map[string]struct{}{}
-	for _, data := range memDb.config.StatVarGroups {
-		for _, child := range data.ChildStatVars {
-			allStatVars[child.Id] = struct{}{}
+	countDescendentStatVars(memDb.config)
+	return nil
+}
+
+// LoadFromGcs loads tmcf + csv files from a GCS folder into memory database.
+// This should be called after LoadConfig() so config is already set.
+func (memDb *MemDb) LoadFromGcs(ctx context.Context, bucket, prefix string) error {
+	src, err := NewGcsSource(ctx, bucket, prefix)
+	if err != nil {
+		return err
+	}
+	return memDb.LoadFromSource(ctx, src)
+}
+
+// LoadFromDir loads tmcf + csv files from a local directory into memory
+// database.
+// This should be called after LoadConfig() so config is already set.
+func (memDb *MemDb) LoadFromDir(ctx context.Context, dir string) error {
+	return memDb.LoadFromSource(ctx, NewLocalSource(dir))
+}
+
+// importFiles holds the files of one import.
+type importFiles struct {
+	dir      string
+	tmcfs    []string
+	csvs     []string
+	manifest string
+}
+
+// groupImports groups files by import. Each directory with tmcf files is an
+// import, and a csv file belongs to the import of its nearest directory.
+//
+// As in the single tmcf layout, a csv file without tmcf in its directories
+// belongs to the first import.
+func groupImports(files []string) ([]*importFiles, error) {
+	sort.Strings(files)
+	imports := map[string]*importFiles{}
+	dirs := []string{}
+	for _, file := range files {
+		if strings.HasSuffix(file, ".tmcf") {
+			dir := path.Dir(file)
+			if _, ok := imports[dir]; !ok {
+				imports[dir] = &importFiles{dir: dir}
+				dirs = append(dirs, dir)
+			}
+			imports[dir].tmcfs = append(imports[dir].tmcfs, file)
 		}
 	}
-	for sv := range allStatVars {
-		curr := sv
+	for _, file := range files {
+		if path.Base(file) == ManifestFile {
+			if imp, ok := imports[path.Dir(file)]; ok {
+				imp.manifest = file
+			}
+			continue
+		}
+		if !strings.HasSuffix(file, ".csv") {
+			continue
+		}
+		if len(dirs) == 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "No tmcf found for csv %s", file)
+		}
+		dir := path.Dir(file)
 		for {
-			parent, ok := parentSvg[curr]
-			if !ok {
+			if imp, ok := imports[dir]; ok {
+				imp.csvs = append(imp.csvs, file)
 				break
 			}
-			memDb.config.StatVarGroups[parent].DescendentStatVarCount++
-			curr = parent
+			parent := path.Dir(dir)
+			if parent == dir {
+				imports[dirs[0]].csvs = append(imports[dirs[0]].csvs, file)
+				break
+			}
+			dir = parent
 		}
 	}
-	return nil
+	result := []*importFiles{}
+	for _, dir := range dirs {
+		result = append(result, imports[dir])
+	}
+	return result, nil
 }
 
-// LoadFromGcs loads tmcf + csv files into memory database
-// This should be called after LoadConfig() so config is already set.
-func (memDb *MemDb) LoadFromGcs(ctx context.Context, bucket, prefix string) error {
-	memDb.lock.Lock()
-	defer memDb.lock.Unlock()
-	memDb.statSeries = map[string]map[string][]*pb.Series{}
-	gcsClient, err := storage.NewClient(ctx)
+func readSourceFile(ctx context.Context, src Source, name string) ([]byte, error) {
+	r, err := src.Open(ctx, name)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	// The bucket should contain one tmcf and multiple compatible csv files.
-	bkt := gcsClient.Bucket(bucket)
-	objectQuery := &storage.Query{Prefix: prefix}
-	var objects []string
-	it := bkt.Objects(ctx, objectQuery)
-	for {
-		attrs, err := it.Next()
-		if err == iterator.Done {
-			break
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
+// readSchemaMapping reads the table schemas of all the tmcf files of an import.
+// When multiple tmcf files have the same table, the first one is used.
+func readSchemaMapping(
+	ctx context.Context, src Source, imp *importFiles,
+) (map[string]*tmcf.TableSchema, error) {
+	result := map[string]*tmcf.TableSchema{}
+	for _, file := range imp.tmcfs {
+		data, err := readSourceFile(ctx, src, file)
+		if err != nil {
+			return nil, err
 		}
+		schemaMapping, err := tmcf.ParseTmcf(string(data))
 		if err != nil {
-			return err
+			return nil, err
 		}
-		objects = append(objects, attrs.Name)
-	}
-	// Read TMCF
-	var schemaMapping map[string]*tmcf.TableSchema
-	for _, object := range objects {
-		if strings.HasSuffix(object, ".tmcf") {
-			obj := bkt.Object(object)
-			r, err := obj.NewReader(ctx)
-			if err != nil {
-				return err
-			}
-			defer r.Close()
-			buf := new(strings.Builder)
-			if _, err := io.Copy(buf, r); err != nil {
-				return err
-			}
-			schemaMapping, err = tmcf.ParseTmcf(buf.String())
-			if err != nil {
-				return err
+		for table, schema := range schemaMapping {
+			if _, ok := result[table]; ok {
+				log.Printf("Table %s is in multiple tmcf files of %s, skipped in %s",
+					table, imp.dir, file)
+				continue
 			}
+			result[table] = schema
+		}
+	}
+	return result, nil
+}
+
+// addManifest merges the stat var groups of an import manifest into the memdb
+// config, with the root stat var group of the import as a child of the root
+// stat var group of the config.
+func (memDb *MemDb) addManifest(manifest *pb.MemdbConfig) {
+	if memDb.config.RootSvg == "" {
+		memDb.config = proto.Clone(manifest).(*pb.MemdbConfig)
+		return
+	}
+	if memDb.config.StatVarGroups == nil {
+		memDb.config.StatVarGroups = map[string]*pb.StatVarGroupNode{}
+	}
+	for svg, node := range manifest.StatVarGroups {
+		if _, ok := memDb.config.StatVarGroups[svg]; !ok {
+			memDb.config.StatVarGroups[svg] = proto.Clone(node).(*pb.StatVarGroupNode)
+		}
+	}
+	if manifest.RootSvg == "" || manifest.RootSvg == memDb.config.RootSvg {
+		return
+	}
+	root, ok := memDb.config.StatVarGroups[memDb.config.RootSvg]
+	if !ok {
+		root = &pb.StatVarGroupNode{}
+		memDb.config.StatVarGroups[memDb.config.RootSvg] = root
+	}
+	for _, child := range root.ChildStatVarGroups {
+		if child.Id == manifest.RootSvg {
+			return
+		}
+	}
+	root.ChildStatVarGroups = append(root.ChildStatVarGroups, &pb.StatVarGroupNode_ChildSVG{
+		Id:                manifest.RootSvg,
+		SpecializedEntity: manifest.ImportName,
+		DisplayName:       manifest.ImportName,
+	})
+}
+
+// loadImport loads the csv files of an import, and returns the number of rows
+// added.
+func (memDb *MemDb) loadImport(ctx context.Context, src Source, imp *importFiles) (int, error) {
+	schemaMapping, err := readSchemaMapping(ctx, src, imp)
+	if err != nil {
+		return 0, err
+	}
+	// Rows use the import name and provenance of the import manifest, or of the
+	// memdb config.
+	provenance := &pb.MemdbConfig{
+		ImportName:    memDb.config.ImportName,
+		ProvenanceUrl: memDb.config.ProvenanceUrl,
+	}
+	if imp.manifest != "" {
+		data, err := readSourceFile(ctx, src, imp.manifest)
+		if err != nil {
+			return 0, err
+		}
+		var manifest pb.MemdbConfig
+		if err := protojson.Unmarshal(data, &manifest); err != nil {
+			return 0, status.Errorf(
+				codes.Internal, "Failed to unmarshal manifest %s: %v", imp.manifest, err)
+		}
+		memDb.addManifest(&manifest)
+		if manifest.ImportName != "" {
+			provenance.ImportName = manifest.ImportName
+		}
+		if manifest.ProvenanceUrl != "" {
+			provenance.ProvenanceUrl = manifest.ProvenanceUrl
+		}
+	}
+	count := 0
+	for _, file := range imp.csvs {
+		n, err := memDb.loadCsv(ctx, src, file, schemaMapping, provenance)
+		count += n
+		if err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
+
+// loadCsv loads the rows of a csv file, and returns the number of rows added.
+func (memDb *MemDb) loadCsv(
+	ctx context.Context,
+	src Source,
+	file string,
+	schemaMapping map[string]*tmcf.TableSchema,
+	provenance *pb.MemdbConfig,
+) (int, error) {
+	r, err := src.Open(ctx, file)
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+	tableName := strings.TrimSuffix(path.Base(file), ".csv")
+	csvReader := csv.NewReader(r)
+	header, err := csvReader.Read()
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for {
+		row, err := csvReader.Read()
+		if err == io.EOF {
 			break
 		}
+		if err != nil {
+			return count, err
+		}
+		err = memDb.addRow(header, row, schemaMapping[tableName], provenance)
+		if err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+// LoadFromSource loads the imports of a source into memory database.
+//
+// Each directory with tmcf files is an import, and the csv files in it and its
+// subdirectories without tmcf files are mapped to the tmcf tables by base file
+// name. Other csv files belong to the first import, so a source with one tmcf
+// applies it to all the csv files. An import can have a ManifestFile with its
+// import name, provenance and stat var groups; otherwise the ones in the memdb
+// config are used.
+//
+// This should be called after LoadConfig() so config is already set.
+func (memDb *MemDb) LoadFromSource(ctx context.Context, src Source) error {
+	memDb.lock.Lock()
+	defer memDb.lock.Unlock()
+	memDb.statSeries = map[string]map[string][]*pb.Series{}
+	memDb.triples = newTripleStore()
+	files, err := src.List(ctx)
+	if err != nil {
+		return err
+	}
+	imports, err := groupImports(files)
+	if err != nil {
+		return err
 	}
 	count := 0
-	for _, object := range objects {
-		if strings.HasSuffix(object, ".csv") {
-			obj := bkt.Object(object)
-			r, err := obj.NewReader(ctx)
-			if err != nil {
-				return err
-			}
-			defer r.Close()
-			tableName := strings.TrimSuffix(filepath.Base(object), ".csv")
-			csvReader := csv.NewReader(r)
-			header, err := csvReader.Read()
-			if err != nil {
-				return err
-			}
-			for {
-				row, err := csvReader.Read()
-				if err == io.EOF {
-					break
-				}
-				if err != nil {
-					return err
-				}
-				err = memDb.addRow(header, row, schemaMapping[tableName])
-				if err != nil {
-					return err
-				}
-				count++
-			}
+	for _, imp := range imports {
+		n, err := memDb.loadImport(ctx, src, imp)
+		if err != nil {
+			return err
 		}
+		log.Printf("Number of csv rows added from %s: %d", imp.dir, n)
+		count += n
 	}
 	log.Printf("Number of csv rows added: %d", count)
+	countDescendentStatVars(memDb.config)
 	// Populate placeSvExistence field
 	parentSvg := getParentSvg(memDb.config.StatVarGroups)
 	memDb.placeSvExistence = buildMemSVExistenceCache(parentSvg, memDb.statSeries)
@@ -388,11 +681,28 @@ type nodeObs struct {
 	meta    *pb.StatMetadata
 }
 
-// addRow adds one csv row to memdb
+// setMetadata sets a metadata property of an observation, from a tmcf constant
+// or a csv cell. Other properties are ignored.
+func setMetadata(meta *pb.StatMetadata, prop, value string) {
+	switch prop {
+	case "measurementMethod":
+		meta.MeasurementMethod = value
+	case "unit":
+		meta.Unit = value
+	case "scalingFactor":
+		meta.ScalingFactor = value
+	case "observationPeriod":
+		meta.ObservationPeriod = value
+	}
+}
+
+// addRow adds one csv row to memdb. Observations with different metadata are
+// in different series, and the other nodes with a dcid are added as triples.
 func (memDb *MemDb) addRow(
 	header []string,
 	row []string,
 	schemaMapping *tmcf.TableSchema,
+	provenance *pb.MemdbConfig,
 ) error {
 	if schemaMapping == nil {
 		return status.Errorf(
@@ -400,31 +710,27 @@ func (memDb *MemDb) addRow(
 	}
 	// Keyed by node id like "E0"
 	allNodes := map[string]*nodeObs{}
+	// Other nodes, keyed by node id.
+	entityNodes := map[string]*nodeTriples{}
 	// Initialize observation entries with the fixed schema
 	for node, meta := range schemaMapping.NodeSchema {
-		if typ, ok := meta["typeOf"]; ok && typ == "StatVarObservation" {
-			allNodes[node] = &nodeObs{
-				statVar: meta["variableMeasured"],
-				meta: &pb.StatMetadata{
-					ProvenanceUrl: memDb.config.ProvenanceUrl,
-					ImportName:    memDb.config.ImportName,
-				},
-			}
-		}
-		// TODO: handle the case when meta data is specified in the column:
-		// https://github.com/datacommonsorg/data/blob/master/scripts/un/energy/un_energy.tmcf#L8-L10
-		if v, ok := meta["measurementMethod"]; ok {
-			allNodes[node].meta.MeasurementMethod = v
-		}
-		if v, ok := meta["unit"]; ok {
-			allNodes[node].meta.Unit = v
+		if typ, ok := meta["typeOf"]; !ok || typ != "StatVarObservation" {
+			entityNodes[node] = newNodeTriples(meta, schemaMapping.NodeRefs[node])
+			continue
 		}
-		if v, ok := meta["scalingFactor"]; ok {
-			allNodes[node].meta.ScalingFactor = v
+		obs := &nodeObs{
+			statVar: meta["variableMeasured"],
+			place:   meta["observationAbout"],
+			date:    meta["observationDate"],
+			meta: &pb.StatMetadata{
+				ProvenanceUrl: provenance.ProvenanceUrl,
+				ImportName:    provenance.ImportName,
+			},
 		}
-		if v, ok := meta["observationPeriod"]; ok {
-			allNodes[node].meta.ObservationPeriod = v
+		for prop, v := range meta {
+			setMetadata(obs.meta, prop, v)
 		}
+		allNodes[node] = obs
 	}
 
 	// Process each cell
@@ -439,24 +745,32 @@ func (memDb *MemDb) addRow(
 		if cell[0] == '[' && cell[len(cell)-1] == ']' {
 			cell = tmcf.ParseComplexValue(cell)
 		}
-		// Derive node property and value for observation.
+		// Derive node property and value for observation. Values in columns
+		// override the constants in the tmcf.
 		for _, col := range schemaMapping.ColumnInfo[colName] {
-			n := col.Node
-			if _, ok := allNodes[n]; !ok {
+			if n, ok := entityNodes[col.Node]; ok {
+				n.addCell(col.Property, cell)
 				continue
 			}
-			if col.Property == "value" {
-				allNodes[n].value = cell
-			}
-			// TODO: handle the case when observationDate is a constant in the tmcf.
-			if col.Property == "observationDate" {
-				allNodes[n].date = cell
+			obs, ok := allNodes[col.Node]
+			if !ok {
+				continue
 			}
-			if col.Property == "observationAbout" {
-				allNodes[n].place = cell
+			switch col.Property {
+			case "value":
+				obs.value = cell
+			case "observationDate":
+				obs.date = cell
+			case "observationAbout":
+				obs.place = cell
+			default:
+				setMetadata(obs.meta, col.Property, tmcf.TrimValue(cell))
 			}
 		}
 	}
+	for _, n := range entityNodes {
+		memDb.triples.add(n)
+	}
 	// Populate observation in the final result.
 	for _, obs := range allNodes {
 		if _, ok := memDb.statSeries[obs.statVar]; !ok {
@@ -472,9 +786,10 @@ func (memDb *MemDb) addRow(
 			}
 			exist := false
 			for _, series := range memDb.statSeries[obs.statVar][obs.place] {
-				if series.Metadata.String() == obs.meta.String() {
+				if proto.Equal(series.Metadata, obs.meta) {
 					series.Val[obs.date] = v
 					exist = true
+					break
 				}
 			}
 			if !exist {
@@ -15,6 +15,10 @@
 package memdb
 
 import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/datacommonsorg/mixer/internal/parser/tmcf"
@@ -177,7 +181,7 @@ func TestAddRow(t *testing.T) {
 		memDb := NewMemDb()
 		for _, row := range c.rows {
 			memDb.config = config
-			err := memDb.addRow(c.header, row, ts)
+			err := memDb.addRow(c.header, row, ts, config)
 			if err != nil {
 				t.Fail()
 			}
@@ -188,3 +192,383 @@ func TestAddRow(t *testing.T) {
 		}
 	}
 }
+
+func TestLoadFromDir(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"covid/covid.tmcf": `Node: E:covid->E0
+typeOf: dcs:StatVarObservation
+variableMeasured: dcs:CumulativeCount_Vaccine_COVID_19_Administered
+observationAbout: C:covid->GeoId
+observationDate: C:covid->Date
+value: C:covid->Count
+`,
+		"covid/data/covid.csv": "GeoId,Date,Count\ncountry/USA,2020-03-22,200\n",
+		"food/food.tmcf": `Node: E:food->E0
+typeOf: dcs:StatVarObservation
+variableMeasured: dcs:Annual_FoodBudgetShortfall
+observationAbout: C:food->GeoId
+observationDate: C:food->Date
+value: C:food->Shortfall
+`,
+		"food/food.csv": "GeoId,Date,Shortfall\ngeoId/06,2019,1000\n",
+		"food/memdb.json": `{
+			"importName": "Feeding America",
+			"provenanceUrl": "https://www.feedingamerica.org/",
+			"rootSvg": "g/Feeding_America",
+			"statVarGroups": {
+				"g/Feeding_America": {
+					"childStatVars": [{"id": "Annual_FoodBudgetShortfall"}]
+				}
+			}
+		}`,
+	} {
+		file := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(file, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	memDb := NewMemDb()
+	memDb.config = &pb.MemdbConfig{
+		ImportName:    "Private Import",
+		ProvenanceUrl: "private.domain",
+		RootSvg:       "g/Private",
+		StatVarGroups: map[string]*pb.StatVarGroupNode{
+			"g/Private": {
+				ChildStatVars: []*pb.StatVarGroupNode_ChildSV{
+					{Id: "CumulativeCount_Vaccine_COVID_19_Administered"},
+				},
+			},
+		},
+	}
+	if err := memDb.LoadFromDir(context.Background(), dir); err != nil {
+		t.Fatalf("LoadFromDir() = %s", err)
+	}
+
+	for _, c := range []struct {
+		statVar string
+		place   string
+		want    []*pb.Series
+	}{
+		{
+			"CumulativeCount_Vaccine_COVID_19_Administered",
+			"country/USA",
+			[]*pb.Series{{
+				Val: map[string]float64{"2020-03-22": 200},
+				Metadata: &pb.StatMetadata{
+					ImportName:    "Private Import",
+					ProvenanceUrl: "private.domain",
+				},
+			}},
+		},
+		{
+			"Annual_FoodBudgetShortfall",
+			"geoId/06",
+			[]*pb.Series{{
+				Val: map[string]float64{"2019": 1000},
+				Metadata: &pb.StatMetadata{
+					ImportName:    "Feeding America",
+					ProvenanceUrl: "https://www.feedingamerica.org/",
+				},
+			}},
+		},
+	} {
+		got := memDb.ReadSeries(c.statVar, c.place)
+		if diff := cmp.Diff(got, c.want, protocmp.Transform()); diff != "" {
+			t.Errorf("ReadSeries(%s, %s) got diff: %v", c.statVar, c.place, diff)
+		}
+	}
+	svg := memDb.GetSvg()
+	if got := svg["g/Private"].GetDescendentStatVarCount(); got != 2 {
+		t.Errorf("g/Private has %d descendent stat vars, want 2", got)
+	}
+	if got := memDb.GetPlaceSvExistence()["g/Feeding_America"]["geoId/06"]; got != 1 {
+		t.Errorf("g/Feeding_America has %d stat vars for geoId/06, want 1", got)
+	}
+}
+
+func TestGroupImports(t *testing.T) {
+	got, err := groupImports([]string{
+		"b/b.tmcf", "b/x/b.csv", "a/a.tmcf", "a/a.csv", "c/c.csv", "b/memdb.json",
+	})
+	if err != nil {
+		t.Fatalf("groupImports() = %s", err)
+	}
+	want := []*importFiles{
+		{dir: "a", tmcfs: []string{"a/a.tmcf"}, csvs: []string{"a/a.csv", "c/c.csv"}},
+		{dir: "b", tmcfs: []string{"b/b.tmcf"}, csvs: []string{"b/x/b.csv"}, manifest: "b/memdb.json"},
+	}
+	if diff := cmp.Diff(got, want, cmp.AllowUnexported(importFiles{})); diff != "" {
+		t.Errorf("groupImports() got diff: %v", diff)
+	}
+
+	if _, err := groupImports([]string{"a/a.csv"}); err == nil {
+		t.Errorf("groupImports() got no error for csv without any tmcf")
+	}
+}
+
+func TestLoadFromDirSingleTmcf(t *testing.T) {
+	// A single tmcf applies to all the csv files, wherever they are.
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"tmcf/food.tmcf": `Node: E:food->E0
+typeOf: dcs:StatVarObservation
+variableMeasured: dcs:Annual_FoodBudgetShortfall
+observationAbout: C:food->GeoId
+observationDate: C:food->Date
+value: C:food->Shortfall
+`,
+		"csv/2019/food.csv": "GeoId,Date,Shortfall\ngeoId/06,2019,1000\n",
+		"csv/2020/food.csv": "GeoId,Date,Shortfall\ngeoId/06,2020,1200\n",
+	} {
+		file := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(file, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	memDb := NewMemDb()
+	memDb.config = config
+	if err := memDb.LoadFromDir(context.Background(), dir); err != nil {
+		t.Fatalf("LoadFromDir() = %s", err)
+	}
+	got := memDb.ReadSeries("Annual_FoodBudgetShortfall", "geoId/06")
+	want := []*pb.Series{{
+		Val: map[string]float64{"2019": 1000, "2020": 1200},
+		Metadata: &pb.StatMetadata{
+			ImportName:    "Private Import",
+			ProvenanceUrl: "private.domain",
+		},
+	}}
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("ReadSeries() got diff: %v", diff)
+	}
+}
+
+func TestAddRowColumnMetadata(t *testing.T) {
+	schema := &tmcf.TableSchema{
+		ColumnInfo: map[string][]*tmcf.Column{
+			"Country": {{Node: "E0", Property: "observationAbout"}},
+			"Unit":    {{Node: "E0", Property: "unit"}},
+			"Method":  {{Node: "E0", Property: "measurementMethod"}},
+			"Value":   {{Node: "E0", Property: "value"}},
+		},
+		NodeSchema: map[string]map[string]string{
+			"E0": {
+				"measurementMethod": "UNEnergy",
+				"observationDate":   "2019",
+				"typeOf":            "StatVarObservation",
+				"variableMeasured":  "Annual_Generation_Electricity",
+			},
+		},
+	}
+	header := []string{"Country", "Unit", "Method", "Value"}
+	memDb := NewMemDb()
+	for _, row := range [][]string{
+		{"country/USA", "KilowattHour", "dcs:UNEnergy_Gross", "100"},
+		{"country/USA", "MegawattHour", "", "0.2"},
+		{"country/USA", "KilowattHour", "dcs:UNEnergy_Gross", "101"},
+	} {
+		if err := memDb.addRow(header, row, schema, config); err != nil {
+			t.Fatalf("addRow(%v) = %s", row, err)
+		}
+	}
+	want := []*pb.Series{
+		{
+			Val: map[string]float64{"2019": 101},
+			Metadata: &pb.StatMetadata{
+				MeasurementMethod: "UNEnergy_Gross",
+				Unit:              "KilowattHour",
+				ImportName:        "Private Import",
+				ProvenanceUrl:     "private.domain",
+			},
+		},
+		{
+			Val: map[string]float64{"2019": 0.2},
+			Metadata: &pb.StatMetadata{
+				MeasurementMethod: "UNEnergy",
+				Unit:              "MegawattHour",
+				ImportName:        "Private Import",
+				ProvenanceUrl:     "private.domain",
+			},
+		},
+	}
+	got := memDb.statSeries["Annual_Generation_Electricity"]["country/USA"]
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("addRow() got diff: %v", diff)
+	}
+}
+
+func TestAddRowTriples(t *testing.T) {
+	schema, err := tmcf.ParseTmcf(`Node: E:City->E0
+typeOf: dcs:City
+dcid: C:City->Dcid
+name: C:City->Name
+containedInPlace: C:City->State
+
+Node: E:City->E1
+typeOf: dcs:StatisticalVariable
+dcid: dcid:Count_Person_Resident
+populationType: dcs:Person
+measuredProperty: dcs:count
+residentStatus: dcs:Resident
+memberOf: l:PopulationGroup
+name: "Resident Population"
+
+Node: E:City->E2
+typeOf: dcs:StatVarObservation
+variableMeasured: dcs:Count_Person_Resident
+observationAbout: C:City->Dcid
+observationDate: "2020"
+value: C:City->Count
+`)
+	if err != nil {
+		t.Fatalf("ParseTmcf() = %s", err)
+	}
+	header := []string{"Dcid", "Name", "State", "Count"}
+	memDb := NewMemDb()
+	for _, row := range [][]string{
+		{"wikidataId/Q1", "Springfield", "dcid:geoId/17", "100"},
+		{"wikidataId/Q2", "Shelbyville", "dcid:geoId/17", "50"},
+	} {
+		if err := memDb.addRow(header, row, schema["City"], config); err != nil {
+			t.Fatalf("addRow(%v) = %s", row, err)
+		}
+	}
+
+	for _, c := range []struct {
+		dcid      string
+		property  string
+		direction string
+		want      []*pb.EntityInfo
+	}{
+		{
+			"wikidataId/Q1", "name", "out",
+			[]*pb.EntityInfo{{Value: "Springfield"}},
+		},
+		{
+			"wikidataId/Q1", "containedInPlace", "out",
+			[]*pb.EntityInfo{{Dcid: "geoId/17"}},
+		},
+		{
+			"Count_Person_Resident", "populationType", "out",
+			[]*pb.EntityInfo{{Dcid: "Person"}},
+		},
+		{
+			"Count_Person_Resident", "memberOf", "out",
+			[]*pb.EntityInfo{{Dcid: "PopulationGroup"}},
+		},
+		{
+			"geoId/17", "containedInPlace", "in",
+			[]*pb.EntityInfo{
+				{Dcid: "wikidataId/Q1", Name: "Springfield", Types: []string{"City"}},
+				{Dcid: "wikidataId/Q2", Name: "Shelbyville", Types: []string{"City"}},
+			},
+		},
+	} {
+		got := memDb.ReadPropertyValues(c.dcid, c.property, c.direction)
+		if diff := cmp.Diff(got, c.want, protocmp.Transform()); diff != "" {
+			t.Errorf("ReadPropertyValues(%s, %s, %s) got diff: %v",
+				c.dcid, c.property, c.direction, diff)
+		}
+	}
+	wantProps := []string{
+		"measuredProperty", "memberOf", "name", "populationType", "residentStatus", "typeOf"}
+	if diff := cmp.Diff(memDb.ReadProperties("Count_Person_Resident", "out"), wantProps); diff != "" {
+		t.Errorf("ReadProperties() got diff: %v", diff)
+	}
+
+	wantSummary := &pb.StatVarSummary{
+		ProvenanceSummary: map[string]*pb.StatVarSummary_ProvenanceSummary{
+			"Private Import": {
+				ImportName:       "Private Import",
+				ObservationCount: 2,
+				TimeSeriesCount:  2,
+				SeriesSummary: []*pb.StatVarSummary_SeriesSummary{{
+					SeriesKey:        &pb.StatVarSummary_SeriesSummary_SeriesKey{},
+					EarliestDate:     "2020",
+					LatestDate:       "2020",
+					ObservationCount: 2,
+					TimeSeriesCount:  2,
+					MinValue:         50,
+					MaxValue:         100,
+				}},
+			},
+		},
+	}
+	got := memDb.ReadStatVarSummary("Count_Person_Resident")
+	if diff := cmp.Diff(got, wantSummary, protocmp.Transform()); diff != "" {
+		t.Errorf("ReadStatVarSummary() got diff: %v", diff)
+	}
+}
+
+func TestReadPointValue(t *testing.T) {
+	memDb := NewMemDb()
+	header := []string{"CumulativeCount_Vaccine_COVID_19_Administered",
+		"IncrementalCount_Vaccine_COVID_19_Administered", "GeoId", "Date"}
+	for _, row := range [][]string{
+		{"10", "1", "geoId/06", "2019-12"},
+		{"20", "2", "geoId/06", "2020-07"},
+		{"30", "3", "geoId/06", "2022-01"},
+	} {
+		if err := memDb.addRow(header, row, ts, config); err != nil {
+			t.Fatalf("addRow(%v) = %s", row, err)
+		}
+	}
+	sv := "CumulativeCount_Vaccine_COVID_19_Administered"
+	for _, c := range []struct {
+		date  string
+		match pb.DateMatch
+		want  *pb.PointStat
+	}{
+		{"2020-07", pb.DateMatch_DATE_MATCH_EXACT, &pb.PointStat{Date: "2020-07", Value: 20}},
+		{"2020", pb.DateMatch_DATE_MATCH_EXACT, nil},
+		{"2020", pb.DateMatch_DATE_MATCH_SAME_YEAR, &pb.PointStat{Date: "2020-07", Value: 20}},
+		{"2021", pb.DateMatch_DATE_MATCH_NEAREST_BEFORE, &pb.PointStat{Date: "2020-07", Value: 20}},
+		{"2021", pb.DateMatch_DATE_MATCH_NEAREST_AFTER, &pb.PointStat{Date: "2022-01", Value: 30}},
+		{"2021-11", pb.DateMatch_DATE_MATCH_NEAREST, &pb.PointStat{Date: "2022-01", Value: 30}},
+		{"", pb.DateMatch_DATE_MATCH_EXACT, &pb.PointStat{Date: "2022-01", Value: 30}},
+	} {
+		got, _ := memDb.ReadPointValue(sv, "geoId/06", c.date, c.match)
+		if diff := cmp.Diff(got, c.want, protocmp.Transform()); diff != "" {
+			t.Errorf("ReadPointValue(%s, %s) got diff: %v", c.date, c.match, diff)
+		}
+	}
+}
+
+func TestMatchPointValue(t *testing.T) {
+	census := &pb.StatMetadata{MeasurementMethod: "CensusACS5yrSurvey"}
+	survey := &pb.StatMetadata{MeasurementMethod: "Survey"}
+	seriesList := []*pb.Series{
+		{Val: map[string]float64{"2015": 15, "2019": 19}, Metadata: census},
+		{Val: map[string]float64{"2016": 16, "2021": 21}, Metadata: survey},
+	}
+	for _, c := range []struct {
+		date      string
+		match     pb.DateMatch
+		want      *pb.PointStat
+		wantFacet *pb.StatMetadata
+	}{
+		{"2016", pb.DateMatch_DATE_MATCH_EXACT, &pb.PointStat{Date: "2016", Value: 16}, survey},
+		// The closest date across the series, not the first matched series.
+		{"2020", pb.DateMatch_DATE_MATCH_NEAREST_AFTER, &pb.PointStat{Date: "2021", Value: 21}, survey},
+		{"2020", pb.DateMatch_DATE_MATCH_NEAREST_BEFORE, &pb.PointStat{Date: "2019", Value: 19}, census},
+		{"2017", pb.DateMatch_DATE_MATCH_NEAREST, &pb.PointStat{Date: "2016", Value: 16}, survey},
+		{"2022", pb.DateMatch_DATE_MATCH_EXACT, nil, nil},
+	} {
+		got, facet := MatchPointValue(seriesList, c.date, c.match)
+		if diff := cmp.Diff(got, c.want, protocmp.Transform()); diff != "" {
+			t.Errorf("MatchPointValue(%s, %s) got diff: %v", c.date, c.match, diff)
+		}
+		if diff := cmp.Diff(facet, c.wantFacet, protocmp.Transform()); diff != "" {
+			t.Errorf("MatchPointValue(%s, %s) got facet diff: %v", c.date, c.match, diff)
+		}
+	}
+}
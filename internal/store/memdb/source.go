@@ -0,0 +1,106 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/iterator"
+)
+
+// Source holds the tmcf, csv and manifest files of the imports to load into
+// memory database.
+type Source interface {
+	// List gets the names of all the files, as slash-separated paths.
+	List(ctx context.Context) ([]string, error)
+	// Open opens a file by name.
+	Open(ctx context.Context, name string) (io.ReadCloser, error)
+}
+
+// gcsSource reads the files under a prefix of a GCS bucket.
+type gcsSource struct {
+	bkt    *storage.BucketHandle
+	prefix string
+}
+
+// NewGcsSource creates a source of the objects under a prefix of a GCS bucket.
+func NewGcsSource(ctx context.Context, bucket, prefix string) (Source, error) {
+	gcsClient, err := storage.NewClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &gcsSource{bkt: gcsClient.Bucket(bucket), prefix: prefix}, nil
+}
+
+func (s *gcsSource) List(ctx context.Context) ([]string, error) {
+	var objects []string
+	it := s.bkt.Objects(ctx, &storage.Query{Prefix: s.prefix})
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, attrs.Name)
+	}
+	return objects, nil
+}
+
+func (s *gcsSource) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	return s.bkt.Object(name).NewReader(ctx)
+}
+
+// localSource reads the files under a local directory.
+type localSource struct {
+	dir string
+}
+
+// NewLocalSource creates a source of the files under a local directory. File
+// names are relative to the directory.
+func NewLocalSource(dir string) Source {
+	return &localSource{dir: dir}
+}
+
+func (s *localSource) List(ctx context.Context) ([]string, error) {
+	var files []string
+	err := filepath.Walk(s.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(s.dir, path)
+		if err != nil {
+			return err
+		}
+		files = append(files, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func (s *localSource) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(s.dir, filepath.FromSlash(name)))
+}
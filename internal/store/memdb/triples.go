@@ -0,0 +1,193 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memdb
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/datacommonsorg/mixer/internal/parser/tmcf"
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/util"
+)
+
+// value is the object of a triple, either a node dcid or a string.
+type value struct {
+	dcid string
+	str  string
+}
+
+// localPrefix is the prefix of a reference to a node defined in the same
+// import, like "l:Person1". The node is stored under the id after the prefix.
+const localPrefix = "l:"
+
+// isLocalReference checks whether a value refers to a node defined in the same
+// import.
+func isLocalReference(v string) bool {
+	return strings.HasPrefix(v, localPrefix)
+}
+
+// nodeTriples holds the properties of a node in a csv row.
+type nodeTriples struct {
+	props map[string][]value
+}
+
+// newNodeTriples creates the node with the constant properties in the tmcf.
+func newNodeTriples(schema map[string]string, refs map[string]bool) *nodeTriples {
+	n := &nodeTriples{props: map[string][]value{}}
+	for prop, v := range schema {
+		if refs[prop] || prop == tmcf.TypeOf || isLocalReference(v) {
+			n.props[prop] = append(
+				n.props[prop], value{dcid: strings.TrimPrefix(v, localPrefix)})
+		} else {
+			n.props[prop] = append(n.props[prop], value{str: v})
+		}
+	}
+	return n
+}
+
+// addCell adds a property from a csv cell. The cell refers to a node when it
+// has a namespace prefix, like "dcid:geoId/06", or the local prefix.
+func (n *nodeTriples) addCell(prop, cell string) {
+	if isLocalReference(cell) {
+		n.props[prop] = append(
+			n.props[prop], value{dcid: strings.TrimPrefix(cell, localPrefix)})
+	} else if tmcf.IsReference(cell) || prop == tmcf.TypeOf {
+		n.props[prop] = append(n.props[prop], value{dcid: tmcf.TrimValue(cell)})
+	} else {
+		n.props[prop] = append(n.props[prop], value{str: cell})
+	}
+}
+
+// dcid gets the dcid of the node, or "" when it has none.
+func (n *nodeTriples) dcid() string {
+	for _, v := range n.props["dcid"] {
+		if v.dcid != "" {
+			return v.dcid
+		}
+		return v.str
+	}
+	return ""
+}
+
+// tripleStore holds the triples of the nodes other than observations.
+type tripleStore struct {
+	// dcid -> property -> values
+	out map[string]map[string][]value
+	// dcid -> property -> dcids of the nodes that refer to it
+	in map[string]map[string][]string
+}
+
+func newTripleStore() *tripleStore {
+	return &tripleStore{
+		out: map[string]map[string][]value{},
+		in:  map[string]map[string][]string{},
+	}
+}
+
+// add adds the triples of a node. Nodes without dcid are skipped.
+func (ts *tripleStore) add(n *nodeTriples) {
+	dcid := n.dcid()
+	if dcid == "" {
+		return
+	}
+	if _, ok := ts.out[dcid]; !ok {
+		ts.out[dcid] = map[string][]value{}
+	}
+	for prop, values := range n.props {
+		if prop == "dcid" {
+			continue
+		}
+		for _, v := range values {
+			if containsValue(ts.out[dcid][prop], v) {
+				continue
+			}
+			ts.out[dcid][prop] = append(ts.out[dcid][prop], v)
+			if v.dcid == "" {
+				continue
+			}
+			if _, ok := ts.in[v.dcid]; !ok {
+				ts.in[v.dcid] = map[string][]string{}
+			}
+			ts.in[v.dcid][prop] = append(ts.in[v.dcid][prop], dcid)
+		}
+	}
+}
+
+func containsValue(values []value, v value) bool {
+	for _, item := range values {
+		if item == v {
+			return true
+		}
+	}
+	return false
+}
+
+// entityInfo gets the info of a node, with the name and types from the
+// triples.
+func (ts *tripleStore) entityInfo(dcid string) *pb.EntityInfo {
+	result := &pb.EntityInfo{Dcid: dcid}
+	for _, v := range ts.out[dcid]["name"] {
+		if v.str != "" {
+			result.Name = v.str
+			break
+		}
+	}
+	for _, v := range ts.out[dcid][tmcf.TypeOf] {
+		result.Types = append(result.Types, v.dcid)
+	}
+	return result
+}
+
+// ReadProperties reads the sorted properties of a node in a direction,
+// util.DirectionOut or util.DirectionIn.
+func (memDb *MemDb) ReadProperties(dcid, direction string) []string {
+	memDb.lock.RLock()
+	defer memDb.lock.RUnlock()
+	result := []string{}
+	if direction == util.DirectionOut {
+		for prop := range memDb.triples.out[dcid] {
+			result = append(result, prop)
+		}
+	} else {
+		for prop := range memDb.triples.in[dcid] {
+			result = append(result, prop)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// ReadPropertyValues reads the values of a property of a node in a direction,
+// util.DirectionOut or util.DirectionIn.
+func (memDb *MemDb) ReadPropertyValues(dcid, property, direction string) []*pb.EntityInfo {
+	memDb.lock.RLock()
+	defer memDb.lock.RUnlock()
+	result := []*pb.EntityInfo{}
+	if direction == util.DirectionOut {
+		for _, v := range memDb.triples.out[dcid][property] {
+			if v.dcid != "" {
+				result = append(result, memDb.triples.entityInfo(v.dcid))
+			} else {
+				result = append(result, &pb.EntityInfo{Value: v.str})
+			}
+		}
+	} else {
+		for _, subject := range memDb.triples.in[dcid][property] {
+			result = append(result, memDb.triples.entityInfo(subject))
+		}
+	}
+	return result
+}
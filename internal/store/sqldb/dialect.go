@@ -0,0 +1,176 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqldb
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+// driverName is the SQLite driver with the BigQuery functions used by the
+// translator registered.
+const driverName = "sqlite3_mixer"
+
+func init() {
+	sql.Register(driverName, &sqlite3.SQLiteDriver{
+		ConnectHook: func(conn *sqlite3.SQLiteConn) error {
+			for name, impl := range map[string]interface{}{
+				"REGEXP_CONTAINS": regexpContains,
+				"STARTS_WITH":     startsWith,
+				"ENDS_WITH":       endsWith,
+				"STRPOS":          strpos,
+			} {
+				if err := conn.RegisterFunc(name, impl, true); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	})
+}
+
+// toString converts a SQLite value to string. ok is false for NULL.
+func toString(v interface{}) (string, bool) {
+	switch x := v.(type) {
+	case nil:
+		return "", false
+	case string:
+		return x, true
+	case []byte:
+		return string(x), true
+	default:
+		return fmt.Sprintf("%v", x), true
+	}
+}
+
+// regexps caches the compiled patterns of REGEXP_CONTAINS, as it is called
+// for every row.
+var regexps sync.Map
+
+// compileRegexp gets the compiled pattern from the cache, or compiles it.
+func compileRegexp(pattern string) (*regexp.Regexp, error) {
+	if re, ok := regexps.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	regexps.Store(pattern, re)
+	return re, nil
+}
+
+func regexpContains(v, pattern interface{}) (interface{}, error) {
+	s, ok1 := toString(v)
+	p, ok2 := toString(pattern)
+	if !ok1 || !ok2 {
+		return nil, nil
+	}
+	re, err := compileRegexp(p)
+	if err != nil {
+		return nil, err
+	}
+	return re.MatchString(s), nil
+}
+
+func startsWith(v, prefix interface{}) interface{} {
+	s, ok1 := toString(v)
+	p, ok2 := toString(prefix)
+	if !ok1 || !ok2 {
+		return nil
+	}
+	return strings.HasPrefix(s, p)
+}
+
+func endsWith(v, suffix interface{}) interface{} {
+	s, ok1 := toString(v)
+	p, ok2 := toString(suffix)
+	if !ok1 || !ok2 {
+		return nil
+	}
+	return strings.HasSuffix(s, p)
+}
+
+// strpos returns the 1-based position of sub in v, or 0 if not found.
+func strpos(v, sub interface{}) interface{} {
+	s, ok1 := toString(v)
+	p, ok2 := toString(sub)
+	if !ok1 || !ok2 {
+		return nil
+	}
+	return int64(strings.Index(s, p) + 1)
+}
+
+// ToSQLite converts a SQL query translated for BigQuery to the SQLite dialect.
+//
+// Double quoted string literals, with the escapes of strconv.Quote, are decoded
+// and become single quoted, and BigQuery only syntax is rewritten. Backtick
+// quoted table names are kept, as SQLite accepts them as identifiers.
+func ToSQLite(query string) string {
+	var result, code strings.Builder
+	flush := func() {
+		s := code.String()
+		s = strings.ReplaceAll(s, " AS STRING)", " AS TEXT)")
+		s = strings.ReplaceAll(s, "UNION DISTINCT", "UNION")
+		result.WriteString(s)
+		code.Reset()
+	}
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		if c == '`' {
+			end := strings.IndexByte(query[i+1:], '`')
+			if end < 0 {
+				code.WriteString(query[i:])
+				break
+			}
+			code.WriteString(query[i : i+end+2])
+			i += end + 1
+			continue
+		}
+		if c != '"' {
+			code.WriteByte(c)
+			continue
+		}
+		flush()
+		end := i + 1
+		for ; end < len(query) && query[end] != '"'; end++ {
+			if query[end] == '\\' {
+				end++
+			}
+		}
+		if end > len(query) {
+			end = len(query)
+		}
+		var literal string
+		var err error
+		if end < len(query) {
+			literal, err = strconv.Unquote(query[i : end+1])
+		}
+		if end == len(query) || err != nil {
+			// Keep the literal as is when it can not be decoded.
+			literal = query[i+1 : end]
+		}
+		result.WriteString("'" + strings.ReplaceAll(literal, "'", "''") + "'")
+		i = end
+	}
+	flush()
+	return result.String()
+}
@@ -0,0 +1,581 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sqldb holds a local SQLite database with the same tables as the
+// BigQuery dataset described by the schema mapping, so translated Sparql
+// queries can run without BigQuery.
+package sqldb
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/datacommonsorg/mixer/internal/parser/tmcf"
+	"github.com/datacommonsorg/mixer/internal/translator/types"
+	"github.com/datacommonsorg/mixer/internal/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	dcidPred    = "dcid"
+	subTypePred = "subType"
+	// Type of the schema mapping entity for nodes without a more specific table.
+	thingType = "Thing"
+)
+
+// Columns of the Triple table.
+var tripleColumns = []string{
+	"subject_id", "predicate", "object_value", "object_id", "prov_id",
+}
+
+// value is a property value of a node.
+type value struct {
+	str string
+	// Whether the value is a reference to another node.
+	ref bool
+}
+
+// node is a graph node read from MCF or TMCF + CSV.
+type node struct {
+	dcid string
+	pvs  map[string][]value
+}
+
+// table is a table in the schema mapping.
+type table struct {
+	name string
+	// Columns in creation order.
+	columns []string
+	// Columns holding node dcids, which are never converted to numbers.
+	idColumns map[string]bool
+}
+
+// entity is a node entity of a table in the schema mapping, like
+// "E:Place->E1". Each row of the table has one such node.
+type entity struct {
+	table   *table
+	types   []string
+	subType string
+	// Keyed by property, valued by column.
+	columns map[string]string
+}
+
+// schema holds the tables and node entities of the schema mapping.
+type schema struct {
+	tables map[string]*table
+	// The Triple table, which holds all the property values.
+	triple *table
+	// Keyed by node type.
+	entities   map[string][]*entity
+	subTypeMap map[string]string
+	// Properties whose values are node references.
+	refProps map[string]bool
+}
+
+func (t *table) addColumn(col string, isID bool) {
+	for _, c := range t.columns {
+		if c == col {
+			t.idColumns[col] = t.idColumns[col] || isID
+			return
+		}
+	}
+	t.columns = append(t.columns, col)
+	t.idColumns[col] = isID
+}
+
+// newSchema builds the tables and the row entities from schema mappings.
+func newSchema(mappings []*types.Mapping, subTypeMap map[string]string) *schema {
+	s := &schema{
+		tables:     map[string]*table{},
+		entities:   map[string][]*entity{},
+		subTypeMap: subTypeMap,
+		refProps:   map[string]bool{},
+	}
+	getTable := func(name string) *table {
+		name = strings.Trim(name, "`")
+		if _, ok := s.tables[name]; !ok {
+			s.tables[name] = &table{name: name, idColumns: map[string]bool{}}
+		}
+		return s.tables[name]
+	}
+	// Column of the dcid of each entity, and the entities referenced by others.
+	dcidColumn := map[string]string{}
+	referenced := map[string]bool{}
+	for _, m := range mappings {
+		if m.IsTriple() {
+			s.triple = getTable(m.Sub.Table.Name)
+			for _, col := range tripleColumns {
+				s.triple.addColumn(col, col != "object_value")
+			}
+			continue
+		}
+		if pred, ok := m.Pred.(string); ok && pred == dcidPred {
+			if col, ok := m.Obj.(types.Column); ok {
+				dcidColumn[m.Sub.Key()] = col.Name
+			}
+		}
+		if e, ok := m.Obj.(types.Entity); ok {
+			referenced[e.Key()] = true
+			if pred, ok := m.Pred.(string); ok {
+				s.refProps[pred] = true
+			}
+		}
+	}
+
+	entities := map[string]*entity{}
+	keys := []string{}
+	for _, m := range mappings {
+		if m.IsTriple() || referenced[m.Sub.Key()] {
+			continue
+		}
+		t := getTable(m.Sub.Table.Name)
+		key := m.Sub.Key()
+		e, ok := entities[key]
+		if !ok {
+			e = &entity{table: t, columns: map[string]string{}}
+			entities[key] = e
+			keys = append(keys, key)
+		}
+		pred, ok := m.Pred.(string)
+		if !ok {
+			continue
+		}
+		switch obj := m.Obj.(type) {
+		case string:
+			if pred == tmcf.TypeOf {
+				e.types = append(e.types, obj)
+			}
+		case types.Column:
+			t.addColumn(obj.Name, pred == dcidPred)
+			if pred == subTypePred {
+				e.subType = obj.Name
+			} else {
+				e.columns[pred] = obj.Name
+			}
+		case types.Entity:
+			if col, ok := dcidColumn[obj.Key()]; ok {
+				t.addColumn(col, true)
+				e.columns[pred] = col
+			}
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		e := entities[key]
+		for _, typ := range e.types {
+			s.entities[typ] = append(s.entities[typ], e)
+		}
+	}
+	return s
+}
+
+// entitiesOf gets the table entities to hold a node of the given type.
+func (s *schema) entitiesOf(typ string) []*entity {
+	if e, ok := s.entities[typ]; ok {
+		return e
+	}
+	if parent, ok := s.subTypeMap[typ]; ok {
+		if e, ok := s.entities[parent]; ok {
+			return e
+		}
+	}
+	return s.entities[thingType]
+}
+
+func quoteIdent(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+// createTables creates the tables in the database. Columns holding dcids have
+// TEXT affinity and the others have NUMERIC affinity, so numbers compare as
+// numbers like in BigQuery.
+func (s *schema) createTables(db *sql.DB) error {
+	names := []string{}
+	for name := range s.tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		t := s.tables[name]
+		cols := []string{}
+		for _, col := range t.columns {
+			affinity := "NUMERIC"
+			if t.idColumns[col] {
+				affinity = "TEXT"
+			}
+			cols = append(cols, fmt.Sprintf("%s %s", quoteIdent(col), affinity))
+		}
+		_, err := db.Exec(fmt.Sprintf(
+			"CREATE TABLE %s (%s)", quoteIdent(name), strings.Join(cols, ", ")))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insert adds the rows of a node to the typed tables and the Triple table.
+func (s *schema) insert(tx *sql.Tx, n *node) error {
+	firstValue := func(prop string) interface{} {
+		if v, ok := n.pvs[prop]; ok && len(v) > 0 {
+			return v[0].str
+		}
+		return nil
+	}
+	prov := firstValue("provenance")
+
+	// A node with several types of the same table only gets one row.
+	inserted := map[*entity]bool{}
+	for _, typ := range n.pvs[tmcf.TypeOf] {
+		for _, e := range s.entitiesOf(typ.str) {
+			if inserted[e] {
+				continue
+			}
+			inserted[e] = true
+			cols := []string{}
+			args := []interface{}{}
+			for prop, col := range e.columns {
+				var v interface{}
+				if prop == dcidPred {
+					v = n.dcid
+				} else {
+					v = firstValue(prop)
+				}
+				if v != nil {
+					cols = append(cols, quoteIdent(col))
+					args = append(args, v)
+				}
+			}
+			if e.subType != "" {
+				cols = append(cols, quoteIdent(e.subType))
+				args = append(args, typ.str)
+			}
+			if len(cols) == 0 {
+				continue
+			}
+			if err := insertRow(tx, e.table.name, cols, args); err != nil {
+				return err
+			}
+		}
+	}
+
+	if s.triple == nil {
+		return nil
+	}
+	props := []string{}
+	for prop := range n.pvs {
+		props = append(props, prop)
+	}
+	sort.Strings(props)
+	for _, prop := range props {
+		if prop == dcidPred {
+			continue
+		}
+		for _, v := range n.pvs[prop] {
+			objCol := "object_value"
+			if v.ref {
+				objCol = "object_id"
+			}
+			err := insertRow(tx, s.triple.name,
+				[]string{"subject_id", "predicate", objCol, "prov_id"},
+				[]interface{}{n.dcid, prop, v.str, prov})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func insertRow(tx *sql.Tx, table string, cols []string, args []interface{}) error {
+	_, err := tx.Exec(fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)",
+		quoteIdent(table),
+		strings.Join(cols, ", "),
+		strings.TrimSuffix(strings.Repeat("?, ", len(cols)), ", ")), args...)
+	return err
+}
+
+// Load creates an in-memory SQLite database with the tables in the schema
+// mappings, and loads the nodes from the MCF files and the TMCF + CSV files
+// in a local directory.
+//
+// A CSV file is mapped by the table of the same name in the TMCF files.
+func Load(
+	dir string,
+	mappings []*types.Mapping,
+	subTypeMap map[string]string,
+) (*sql.DB, error) {
+	defer util.TimeTrack(time.Now(), "sqldb.Load")
+	s := newSchema(mappings, subTypeMap)
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var mcfFiles, csvFiles []string
+	tmcfSchema := map[string]*tmcf.TableSchema{}
+	for _, f := range files {
+		name := filepath.Join(dir, f.Name())
+		switch filepath.Ext(name) {
+		case ".mcf":
+			mcfFiles = append(mcfFiles, name)
+		case ".csv":
+			csvFiles = append(csvFiles, name)
+		case ".tmcf":
+			content, err := ioutil.ReadFile(name)
+			if err != nil {
+				return nil, err
+			}
+			tables, err := tmcf.ParseTmcf(string(content))
+			if err != nil {
+				return nil, err
+			}
+			for t, ts := range tables {
+				tmcfSchema[t] = ts
+			}
+		}
+	}
+
+	db, err := sql.Open(driverName, ":memory:")
+	if err != nil {
+		return nil, err
+	}
+	// Each connection to ":memory:" opens a different database.
+	db.SetMaxOpenConns(1)
+	if err := s.createTables(db); err != nil {
+		return nil, err
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	count := 0
+	for _, name := range mcfFiles {
+		content, err := ioutil.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := parseMcf(string(content))
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range nodes {
+			if err := s.insert(tx, n); err != nil {
+				return nil, err
+			}
+			count++
+		}
+	}
+	for _, name := range csvFiles {
+		tableName := strings.TrimSuffix(filepath.Base(name), ".csv")
+		ts, ok := tmcfSchema[tableName]
+		if !ok {
+			return nil, status.Errorf(
+				codes.InvalidArgument, "no tmcf for csv file %s", name)
+		}
+		n, err := s.loadCsv(tx, name, tableName, ts)
+		if err != nil {
+			return nil, err
+		}
+		count += n
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	log.Printf("Number of nodes added to sqldb: %d", count)
+	return db, nil
+}
+
+// loadCsv inserts the nodes of each CSV row and returns the number of nodes.
+func (s *schema) loadCsv(
+	tx *sql.Tx,
+	name, tableName string,
+	ts *tmcf.TableSchema,
+) (int, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	r := csv.NewReader(f)
+	header, err := r.Read()
+	if err != nil {
+		return 0, err
+	}
+	nodeNames := map[string]bool{}
+	for n := range ts.NodeSchema {
+		nodeNames[n] = true
+	}
+	for _, cols := range ts.ColumnInfo {
+		for _, c := range cols {
+			nodeNames[c.Node] = true
+		}
+	}
+	sortedNames := []string{}
+	for n := range nodeNames {
+		sortedNames = append(sortedNames, n)
+	}
+	sort.Strings(sortedNames)
+
+	count := 0
+	for rowIdx := 0; ; rowIdx++ {
+		row, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		nodes := map[string]*node{}
+		for _, n := range sortedNames {
+			nodes[n] = &node{pvs: map[string][]value{}}
+			for prop, v := range ts.NodeSchema[n] {
+				nodes[n].pvs[prop] = append(nodes[n].pvs[prop], s.toValue(prop, v))
+			}
+		}
+		for i, cell := range row {
+			if i >= len(header) || cell == "" {
+				continue
+			}
+			for _, c := range ts.ColumnInfo[header[i]] {
+				nodes[c.Node].pvs[c.Property] = append(
+					nodes[c.Node].pvs[c.Property], s.toValue(c.Property, cell))
+			}
+		}
+		for _, name := range sortedNames {
+			n := nodes[name]
+			if v, ok := n.pvs[dcidPred]; ok && len(v) > 0 {
+				n.dcid = v[0].str
+			} else {
+				// Rows of CSV nodes without dcid, like observations, get an id from
+				// the position in the CSV file.
+				n.dcid = fmt.Sprintf("%s/%d/%s", tableName, rowIdx, name)
+			}
+			if err := s.insert(tx, n); err != nil {
+				return 0, err
+			}
+			count++
+		}
+	}
+	return count, nil
+}
+
+// localPrefix is the prefix of a reference to a node defined in the same
+// import, like "l:Person1".
+const localPrefix = "l:"
+
+// trimReference removes the namespace or local prefix of a value, and reports
+// whether the value is a reference.
+func trimReference(v string) (string, bool) {
+	if strings.HasPrefix(v, localPrefix) {
+		return strings.TrimPrefix(v, localPrefix), true
+	}
+	return tmcf.TrimValue(v), tmcf.IsReference(v)
+}
+
+// toValue converts a TMCF or CSV value into a node value.
+func (s *schema) toValue(prop, v string) value {
+	str, ref := trimReference(v)
+	return value{str: str, ref: ref || prop == tmcf.TypeOf || s.refProps[prop]}
+}
+
+// parseMcf parses instance MCF into nodes. Quoted values and numbers are
+// literals, and other values are references. A quoted value can span lines.
+//
+// The parser in internal/parser/mcf is not used, as it reads schema mapping MCF
+// into translator mappings, with a single value per property and no literal
+// or reference values.
+func parseMcf(mcf string) ([]*node, error) {
+	result := []*node{}
+	var curr *node
+	lines := strings.Split(mcf, "\n")
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(line, "//") || strings.HasPrefix(line, "#") || line == "" {
+			continue
+		}
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) < 2 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid mcf line: %s", line)
+		}
+		head := strings.TrimSpace(parts[0])
+		body := strings.TrimSpace(parts[1])
+		for strings.Count(body, `"`)%2 == 1 {
+			if i+1 == len(lines) {
+				return nil, status.Errorf(codes.InvalidArgument, "unterminated quote: %s", line)
+			}
+			i++
+			body += "\n" + strings.TrimRight(lines[i], " \t\r")
+		}
+		if head == "Node" {
+			id, _ := trimReference(strings.Trim(body, `"`))
+			curr = &node{dcid: id, pvs: map[string][]value{}}
+			result = append(result, curr)
+			continue
+		}
+		if curr == nil {
+			return nil, status.Error(codes.InvalidArgument, "Missing Node identifier")
+		}
+		for _, v := range splitValues(body) {
+			if strings.HasPrefix(v, `"`) {
+				curr.pvs[head] = append(curr.pvs[head], value{str: strings.Trim(v, `"`)})
+				continue
+			}
+			if _, err := strconv.ParseFloat(v, 64); err == nil {
+				curr.pvs[head] = append(curr.pvs[head], value{str: v})
+				continue
+			}
+			str, _ := trimReference(v)
+			curr.pvs[head] = append(curr.pvs[head], value{str: str, ref: true})
+		}
+	}
+	for _, n := range result {
+		if v, ok := n.pvs[dcidPred]; ok && len(v) > 0 {
+			n.dcid = v[0].str
+		}
+	}
+	return result, nil
+}
+
+// splitValues splits comma separated MCF values, keeping commas in quotes.
+func splitValues(s string) []string {
+	result := []string{}
+	inQuote := false
+	start := 0
+	for i := 0; i <= len(s); i++ {
+		if i < len(s) && s[i] == '"' {
+			inQuote = !inQuote
+		}
+		if i == len(s) || (s[i] == ',' && !inQuote) {
+			if v := strings.TrimSpace(s[start:i]); v != "" {
+				result = append(result, v)
+			}
+			start = i + 1
+		}
+	}
+	return result
+}
@@ -0,0 +1,222 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqldb
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/datacommonsorg/mixer/internal/parser/mcf"
+	"github.com/datacommonsorg/mixer/internal/translator"
+	"github.com/datacommonsorg/mixer/internal/translator/solver"
+	"github.com/datacommonsorg/mixer/internal/translator/sparql"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestToSQLite(t *testing.T) {
+	for _, c := range []struct {
+		query string
+		want  string
+	}{
+		{
+			"SELECT a.id FROM `dc.Place` AS a WHERE a.name = \"O'Brien\"",
+			"SELECT a.id FROM `dc.Place` AS a WHERE a.name = 'O''Brien'",
+		},
+		{
+			`SELECT CAST(a.id AS STRING) AS id FROM t UNION DISTINCT SELECT "a\"b"`,
+			`SELECT CAST(a.id AS TEXT) AS id FROM t UNION SELECT 'a"b'`,
+		},
+		{
+			`SELECT "UNION DISTINCT", "x AS STRING)"`,
+			`SELECT 'UNION DISTINCT', 'x AS STRING)'`,
+		},
+		{
+			`SELECT "a\nb", "a\tb", "\u00e9t\u00e9", "a\\b"`,
+			"SELECT 'a\nb', 'a\tb', 'été', 'a\\b'",
+		},
+		{
+			`SELECT "a`,
+			`SELECT 'a'`,
+		},
+	} {
+		if got := ToSQLite(c.query); got != c.want {
+			t.Errorf("ToSQLite(%s) = %s, want %s", c.query, got, c.want)
+		}
+	}
+}
+
+func TestRegexpContains(t *testing.T) {
+	for _, c := range []struct {
+		v       interface{}
+		pattern interface{}
+		want    interface{}
+	}{
+		{"geoId/06", "^geoId/", true},
+		{[]byte("geoId/06"), "^geoId/", true},
+		{"country/USA", "^geoId/", false},
+		{nil, "^geoId/", nil},
+	} {
+		got, err := regexpContains(c.v, c.pattern)
+		if err != nil {
+			t.Errorf("regexpContains(%v, %v) = %s", c.v, c.pattern, err)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("regexpContains(%v, %v) = %v, want %v", c.v, c.pattern, got, c.want)
+		}
+	}
+	if _, ok := regexps.Load("^geoId/"); !ok {
+		t.Errorf("regexpContains() did not cache the compiled pattern")
+	}
+	if _, err := regexpContains("a", "("); err == nil {
+		t.Errorf("regexpContains() with invalid pattern got no error")
+	}
+}
+
+func TestParseMcf(t *testing.T) {
+	nodes, err := parseMcf(`Node: dcid:geoId/06
+typeOf: dcs:State, dcs:Place
+name: "California", "Golden State, The"
+description: "A state
+on the west coast"
+containedInPlace: l:USA
+area: 423970
+`)
+	if err != nil {
+		t.Fatalf("parseMcf() = %s", err)
+	}
+	want := []*node{{
+		dcid: "geoId/06",
+		pvs: map[string][]value{
+			"typeOf":           {{str: "State", ref: true}, {str: "Place", ref: true}},
+			"name":             {{str: "California"}, {str: "Golden State, The"}},
+			"description":      {{str: "A state\non the west coast"}},
+			"containedInPlace": {{str: "USA", ref: true}},
+			"area":             {{str: "423970"}},
+		},
+	}}
+	if diff := cmp.Diff(nodes, want, cmp.AllowUnexported(node{}, value{})); diff != "" {
+		t.Errorf("parseMcf() got diff: %v", diff)
+	}
+
+	if _, err := parseMcf("Node: dcid:geoId/06\nname: \"California\n"); err == nil {
+		t.Errorf("parseMcf() with unterminated quote got no error")
+	}
+}
+
+func TestLoad(t *testing.T) {
+	schemaMapping, err := ioutil.ReadFile("../../../deploy/mapping/base.mcf")
+	if err != nil {
+		t.Fatalf("ReadFile() = %s", err)
+	}
+	mappings, err := mcf.ParseMapping(string(schemaMapping), "dc")
+	if err != nil {
+		t.Fatalf("ParseMapping() = %s", err)
+	}
+	subTypeMap, err := solver.GetSubTypeMap("../../translator/table_types.json")
+	if err != nil {
+		t.Fatalf("GetSubTypeMap() = %s", err)
+	}
+	db, err := Load("testdata", mappings, subTypeMap)
+	if err != nil {
+		t.Fatalf("Load() = %s", err)
+	}
+	defer db.Close()
+
+	for _, c := range []struct {
+		sparql string
+		want   [][]string
+	}{
+		{
+			`SELECT ?name
+			WHERE {
+				?place typeOf State .
+				?place name ?name
+			}
+			ORDER BY DESC(?name)`,
+			[][]string{{"Washington"}, {"California"}},
+		},
+		{
+			`SELECT ?dcid
+			WHERE {
+				?place typeOf State .
+				?place latitude ?lat .
+				?place dcid ?dcid .
+				FILTER(?lat > 40)
+			}`,
+			[][]string{{"geoId/53"}},
+		},
+		{
+			`SELECT ?date ?value
+			WHERE {
+				?o typeOf StatVarObservation .
+				?o variableMeasured Count_Person .
+				?o observationAbout geoId/06 .
+				?o observationDate ?date .
+				?o value ?value
+			}
+			ORDER BY ?date`,
+			[][]string{{"2019", "39512223"}, {"2020", "39538223"}},
+		},
+		{
+			`SELECT ?name
+			WHERE {
+				?place containedInPlace ?country .
+				?country dcid country/USA .
+				?place name ?name
+			}
+			ORDER BY ?name`,
+			[][]string{{"California"}, {"Washington"}},
+		},
+	} {
+		nodes, queries, opts, err := sparql.ParseQuery(c.sparql)
+		if err != nil {
+			t.Errorf("ParseQuery(%s) = %s", c.sparql, err)
+			continue
+		}
+		translation, err := translator.Translate(
+			mappings, nodes, queries, subTypeMap, opts)
+		if err != nil {
+			t.Errorf("Translate(%s) = %s", c.sparql, err)
+			continue
+		}
+		rows, err := db.Query(ToSQLite(translation.SQL))
+		if err != nil {
+			t.Errorf("Query(%s) = %s", translation.SQL, err)
+			continue
+		}
+		got := [][]string{}
+		for rows.Next() {
+			cells := make([]interface{}, len(nodes))
+			ptrs := make([]interface{}, len(nodes))
+			for i := range cells {
+				ptrs[i] = &cells[i]
+			}
+			if err := rows.Scan(ptrs...); err != nil {
+				t.Fatalf("Scan() = %s", err)
+			}
+			row := []string{}
+			for _, cell := range cells {
+				row = append(row, fmt.Sprintf("%v", cell))
+			}
+			got = append(got, row)
+		}
+		rows.Close()
+		if diff := cmp.Diff(got, c.want); diff != "" {
+			t.Errorf("Query(%s) got diff %v", c.sparql, diff)
+		}
+	}
+}
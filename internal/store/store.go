@@ -15,6 +15,8 @@
 package store
 
 import (
+	"database/sql"
+
 	"cloud.google.com/go/bigquery"
 	"github.com/datacommonsorg/mixer/internal/store/bigtable"
 	"github.com/datacommonsorg/mixer/internal/store/memdb"
@@ -25,6 +27,8 @@ type Store struct {
 	BqClient *bigquery.Client
 	MemDb    *memdb.MemDb
 	BtGroup  *bigtable.Group
+	// Local SQLite database to run Sparql queries without BigQuery.
+	SQLDb *sql.DB
 }
 
 // NewStore creates a new store.
@@ -18,6 +18,9 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"strings"
+
+	"github.com/datacommonsorg/mixer/internal/translator/types"
 )
 
 // ParseError represents an error that occurred during parsing.
@@ -38,8 +41,11 @@ type QueryTree struct {
 	P *Prologue
 	S *Select
 	W *Where
-	O *Orderby
+	G *Groupby
+	O []*Orderby
 	L int
+	// Offset is the number of rows to skip.
+	Offset int
 }
 
 // Prologue represents query prologue information
@@ -48,10 +54,12 @@ type Prologue struct {
 	Prefix map[string]string
 }
 
-// Select contains information in the SELECT statement.
+// Select contains information in the SELECT statement. Variable includes the
+// alias of each aggregate.
 type Select struct {
-	Variable []string
-	Distinct bool
+	Variable   []string
+	Distinct   bool
+	Aggregates []*types.Aggregate
 }
 
 // Triple reprensts a triple in Sparql query.
@@ -63,7 +71,39 @@ type Triple struct {
 
 // Where represents the where condition in Sparql query.
 type Where struct {
-	Triples []Triple
+	Triples  []Triple
+	Filters  []*types.Expr
+	Optional []*Where
+	Union    []*Where
+}
+
+// exprOp maps operator tokens to the operator in a filter expression.
+var exprOp = map[Token]string{
+	AND: "&&",
+	OR:  "||",
+	NOT: "!",
+	EQ:  "=",
+	NEQ: "!=",
+	LT:  "<",
+	LTE: "<=",
+	GT:  ">",
+	GTE: ">=",
+	IN:  "IN",
+}
+
+// aggregateFuncs are the supported aggregate functions.
+var aggregateFuncs = map[string]struct{}{
+	"avg":   {},
+	"count": {},
+	"max":   {},
+	"min":   {},
+	"sum":   {},
+}
+
+// Groupby represents the group by and having conditions.
+type Groupby struct {
+	Variable []string
+	Having   []*types.Expr
 }
 
 // Orderby represents the order by condition.
@@ -139,6 +179,15 @@ func (p *Parser) parseSelect() (*Select, *ParseError) {
 			p.Unscan()
 			return &result, nil
 		}
+		if tok == LPAREN {
+			agg, err := p.parseAggregate()
+			if err != nil {
+				return nil, err
+			}
+			result.Variable = append(result.Variable, agg.Alias)
+			result.Aggregates = append(result.Aggregates, agg)
+			continue
+		}
 		if tok != VARIABLE {
 			return nil, newParseError(tokstr(tok, lit), []string{"?..."}, pos)
 		}
@@ -147,7 +196,6 @@ func (p *Parser) parseSelect() (*Select, *ParseError) {
 }
 
 func (p *Parser) parseWhere() (*Where, *ParseError) {
-	result := Where{}
 	tok, pos, lit := p.ScanIgnoreWhitespace()
 	if tok != WHERE {
 		return nil, newParseError(tokstr(tok, lit), []string{"Where"}, pos)
@@ -156,12 +204,20 @@ func (p *Parser) parseWhere() (*Where, *ParseError) {
 	if tok != LBRAC {
 		return nil, newParseError(tokstr(tok, lit), []string{"{"}, pos)
 	}
+	return p.parseGroup(true)
+}
+
+// parseGroup parses a group graph pattern after the opening "{" up to and
+// including the closing "}". OPTIONAL and UNION blocks are only allowed when
+// nested is true.
+func (p *Parser) parseGroup(nested bool) (*Where, *ParseError) {
+	result := Where{}
 	var sub string
 	var pred string
 	var objs []string
 	idx := 0
 	for {
-		tok, _, lit := p.ScanIgnoreWhitespace()
+		tok, pos, lit := p.ScanIgnoreWhitespace()
 		if tok == EOF {
 			return nil, newParseError(tokstr(tok, lit), []string{"}"}, pos)
 		}
@@ -172,13 +228,61 @@ func (p *Parser) parseWhere() (*Where, *ParseError) {
 			return &result, nil
 		}
 		if tok == DOT {
-			result.Triples = append(result.Triples, Triple{sub, pred, objs})
+			if sub != "" {
+				result.Triples = append(result.Triples, Triple{sub, pred, objs})
+			}
 			idx = 0
 			sub = ""
 			pred = ""
 			objs = []string{}
 			continue
 		}
+		// FILTER, OPTIONAL and UNION blocks start a new pattern, so they can also
+		// follow a complete triple without a "." in between.
+		if idx > 0 && (tok == FILTER || tok == OPTIONAL || tok == LBRAC) {
+			if idx < 2 || len(objs) == 0 {
+				return nil, newParseError(tokstr(tok, lit), []string{"triple object"}, pos)
+			}
+			result.Triples = append(result.Triples, Triple{sub, pred, objs})
+			idx = 0
+			sub = ""
+			pred = ""
+			objs = []string{}
+		}
+		if idx == 0 {
+			switch tok {
+			case FILTER:
+				expr, err := p.parseFilter()
+				if err != nil {
+					return nil, err
+				}
+				result.Filters = append(result.Filters, expr)
+				continue
+			case OPTIONAL:
+				if !nested {
+					return nil, newParseError(tokstr(tok, lit), []string{"triple", "FILTER"}, pos)
+				}
+				if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != LBRAC {
+					return nil, newParseError(tokstr(tok, lit), []string{"{"}, pos)
+				}
+				optional, err := p.parseGroup(false)
+				if err != nil {
+					return nil, err
+				}
+				result.Optional = append(result.Optional, optional)
+				continue
+			case LBRAC:
+				if !nested || len(result.Union) > 0 {
+					return nil, newParseError(tokstr(tok, lit), []string{"triple", "FILTER"}, pos)
+				}
+				union, err := p.parseUnion()
+				if err != nil {
+					return nil, err
+				}
+				result.Union = union
+				continue
+			}
+		}
 		if tok == LPAREN || tok == RPAREN {
 			continue
 		}
@@ -198,9 +302,228 @@ func (p *Parser) parseWhere() (*Where, *ParseError) {
 	}
 }
 
-func (p *Parser) parseOrderBy() (*Orderby, *ParseError) {
-	varString := ""
-	asc := true
+// parseUnion parses "{ ... } UNION { ... }" after the first opening "{".
+func (p *Parser) parseUnion() ([]*Where, *ParseError) {
+	result := []*Where{}
+	for {
+		group, err := p.parseGroup(false)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, group)
+		if tok, _, _ := p.ScanIgnoreWhitespace(); tok != UNION {
+			p.Unscan()
+			break
+		}
+		if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != LBRAC {
+			return nil, newParseError(tokstr(tok, lit), []string{"{"}, pos)
+		}
+	}
+	if len(result) < 2 {
+		tok, pos, lit := p.ScanIgnoreWhitespace()
+		return nil, newParseError(tokstr(tok, lit), []string{"UNION"}, pos)
+	}
+	return result, nil
+}
+
+// parseFilter parses the constraint after the FILTER keyword, which is either
+// a bracketed expression or a function call.
+func (p *Parser) parseFilter() (*types.Expr, *ParseError) {
+	tok, pos, lit := p.ScanIgnoreWhitespace()
+	if tok != LPAREN && tok != IDENT {
+		return nil, newParseError(tokstr(tok, lit), []string{"(", "function"}, pos)
+	}
+	p.Unscan()
+	return p.parsePrimary()
+}
+
+// parseExpr parses a binary expression using precedence climbing.
+func (p *Parser) parseExpr(minPrecedence int) (*types.Expr, *ParseError) {
+	lhs, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok, _, _ := p.ScanIgnoreWhitespace()
+		precedence := tok.Precedence()
+		if precedence == 0 || precedence < minPrecedence {
+			p.Unscan()
+			return lhs, nil
+		}
+		if tok == IN {
+			args, err := p.parseArgs()
+			if err != nil {
+				return nil, err
+			}
+			lhs = &types.Expr{Op: exprOp[tok], Args: append([]*types.Expr{lhs}, args...)}
+			continue
+		}
+		rhs, err := p.parseExpr(precedence + 1)
+		if err != nil {
+			return nil, err
+		}
+		lhs = &types.Expr{Op: exprOp[tok], Args: []*types.Expr{lhs, rhs}}
+	}
+}
+
+func (p *Parser) parseUnary() (*types.Expr, *ParseError) {
+	tok, _, _ := p.ScanIgnoreWhitespace()
+	if tok == NOT {
+		arg, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &types.Expr{Op: exprOp[tok], Args: []*types.Expr{arg}}, nil
+	}
+	p.Unscan()
+	return p.parsePrimary()
+}
+
+func (p *Parser) parsePrimary() (*types.Expr, *ParseError) {
+	tok, pos, lit := p.ScanIgnoreWhitespace()
+	switch tok {
+	case LPAREN:
+		expr, err := p.parseExpr(1)
+		if err != nil {
+			return nil, err
+		}
+		if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != RPAREN {
+			return nil, newParseError(tokstr(tok, lit), []string{")"}, pos)
+		}
+		return expr, nil
+	case VARIABLE:
+		return &types.Expr{Var: lit}, nil
+	case NUMBER:
+		return &types.Expr{Value: lit}, nil
+	case STRING:
+		return &types.Expr{Value: fmt.Sprintf(`"%s"`, lit)}, nil
+	case TRUE, FALSE:
+		return &types.Expr{Value: strings.ToLower(tok.String())}, nil
+	case IDENT:
+		if _, ok := aggregateFuncs[strings.ToLower(lit)]; ok {
+			return p.parseAggregateCall(lit)
+		}
+		args, err := p.parseArgs()
+		if err != nil {
+			return nil, err
+		}
+		return &types.Expr{Op: strings.ToLower(lit), Args: args}, nil
+	}
+	return nil, newParseError(tokstr(tok, lit), []string{"?...", "literal", "("}, pos)
+}
+
+// parseAggregate parses "FUNC(...) AS ?alias)" after the opening "(".
+func (p *Parser) parseAggregate() (*types.Aggregate, *ParseError) {
+	tok, pos, lit := p.ScanIgnoreWhitespace()
+	if _, ok := aggregateFuncs[strings.ToLower(lit)]; tok != IDENT || !ok {
+		return nil, newParseError(tokstr(tok, lit), []string{"COUNT", "SUM", "AVG", "MIN", "MAX"}, pos)
+	}
+	expr, err := p.parseAggregateCall(lit)
+	if err != nil {
+		return nil, err
+	}
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != AS {
+		return nil, newParseError(tokstr(tok, lit), []string{"AS"}, pos)
+	}
+	tok, pos, lit = p.ScanIgnoreWhitespace()
+	if tok != VARIABLE {
+		return nil, newParseError(tokstr(tok, lit), []string{"?..."}, pos)
+	}
+	alias := lit
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != RPAREN {
+		return nil, newParseError(tokstr(tok, lit), []string{")"}, pos)
+	}
+	return &types.Aggregate{Expr: expr, Alias: alias}, nil
+}
+
+// parseAggregateCall parses the arguments of an aggregate function, which is
+// "([DISTINCT] ?var)" or "(*)" for COUNT.
+func (p *Parser) parseAggregateCall(name string) (*types.Expr, *ParseError) {
+	result := &types.Expr{Op: strings.ToLower(name), Args: []*types.Expr{}}
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != LPAREN {
+		return nil, newParseError(tokstr(tok, lit), []string{"("}, pos)
+	}
+	tok, pos, lit := p.ScanIgnoreWhitespace()
+	if tok == DISTINCT {
+		result.Distinct = true
+		tok, pos, lit = p.ScanIgnoreWhitespace()
+	}
+	switch {
+	case tok == VARIABLE:
+		result.Args = append(result.Args, &types.Expr{Var: lit})
+	case tok == MUL && result.Op == "count":
+	default:
+		return nil, newParseError(tokstr(tok, lit), []string{"?..."}, pos)
+	}
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != RPAREN {
+		return nil, newParseError(tokstr(tok, lit), []string{")"}, pos)
+	}
+	return result, nil
+}
+
+// parseArgs parses a bracketed, comma separated expression list.
+func (p *Parser) parseArgs() ([]*types.Expr, *ParseError) {
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != LPAREN {
+		return nil, newParseError(tokstr(tok, lit), []string{"("}, pos)
+	}
+	args := []*types.Expr{}
+	for {
+		arg, err := p.parseExpr(1)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		tok, pos, lit := p.ScanIgnoreWhitespace()
+		if tok == RPAREN {
+			return args, nil
+		}
+		if tok != COMMA {
+			return nil, newParseError(tokstr(tok, lit), []string{",", ")"}, pos)
+		}
+	}
+}
+
+func (p *Parser) parseGroupBy() (*Groupby, *ParseError) {
+	tok, _, _ := p.ScanIgnoreWhitespace()
+	if tok != GROUP {
+		p.Unscan()
+		return nil, nil
+	}
+	tok, pos, lit := p.ScanIgnoreWhitespace()
+	if tok != BY {
+		return nil, newParseError(tokstr(tok, lit), []string{"BY"}, pos)
+	}
+	result := Groupby{}
+	for {
+		tok, pos, lit := p.ScanIgnoreWhitespace()
+		if tok != VARIABLE {
+			if len(result.Variable) == 0 {
+				return nil, newParseError(tokstr(tok, lit), []string{"?..."}, pos)
+			}
+			p.Unscan()
+			break
+		}
+		result.Variable = append(result.Variable, lit)
+	}
+	if tok, _, _ := p.ScanIgnoreWhitespace(); tok != HAVING {
+		p.Unscan()
+		return &result, nil
+	}
+	for {
+		expr, err := p.parseFilter()
+		if err != nil {
+			return nil, err
+		}
+		result.Having = append(result.Having, expr)
+		tok, _, _ := p.ScanIgnoreWhitespace()
+		p.Unscan()
+		if tok != LPAREN && tok != IDENT {
+			return &result, nil
+		}
+	}
+}
+
+func (p *Parser) parseOrderBy() ([]*Orderby, *ParseError) {
 	tok, _, _ := p.ScanIgnoreWhitespace()
 	if tok == EOF {
 		return nil, nil
@@ -213,29 +536,34 @@ func (p *Parser) parseOrderBy() (*Orderby, *ParseError) {
 	if tok != BY {
 		return nil, newParseError(tokstr(tok, lit), []string{"BY"}, pos)
 	}
-	tok, pos, lit = p.ScanIgnoreWhitespace()
-	if tok == ASC || tok == DESC {
-		asc = tok == ASC
-		tok, pos, lit = p.ScanIgnoreWhitespace()
-		if tok != LPAREN {
-			return nil, newParseError(tokstr(tok, lit), []string{"("}, pos)
-		}
-		tok, pos, lit = p.ScanIgnoreWhitespace()
-		if tok != VARIABLE {
-			return nil, newParseError(tokstr(tok, lit), []string{"?..."}, pos)
-		}
-		varString = lit
+	result := []*Orderby{}
+	for {
 		tok, pos, lit = p.ScanIgnoreWhitespace()
-		if tok != RPAREN {
-			return nil, newParseError(tokstr(tok, lit), []string{")"}, pos)
-		}
-	} else {
-		if tok != VARIABLE {
+		if tok == ASC || tok == DESC {
+			asc := tok == ASC
+			tok, pos, lit = p.ScanIgnoreWhitespace()
+			if tok != LPAREN {
+				return nil, newParseError(tokstr(tok, lit), []string{"("}, pos)
+			}
+			tok, pos, lit = p.ScanIgnoreWhitespace()
+			if tok != VARIABLE {
+				return nil, newParseError(tokstr(tok, lit), []string{"?..."}, pos)
+			}
+			varString := lit
+			tok, pos, lit = p.ScanIgnoreWhitespace()
+			if tok != RPAREN {
+				return nil, newParseError(tokstr(tok, lit), []string{")"}, pos)
+			}
+			result = append(result, &Orderby{varString, asc})
+		} else if tok == VARIABLE {
+			result = append(result, &Orderby{lit, true})
+		} else if len(result) == 0 {
 			return nil, newParseError(tokstr(tok, lit), []string{"?..."}, pos)
+		} else {
+			p.Unscan()
+			return result, nil
 		}
-		varString = lit
 	}
-	return &Orderby{varString, asc}, nil
 }
 
 func (p *Parser) parseLimit() (int, *ParseError) {
@@ -258,6 +586,22 @@ func (p *Parser) parseLimit() (int, *ParseError) {
 	return limit, nil
 }
 
+func (p *Parser) parseOffset() (int, *ParseError) {
+	tok, pos, lit := p.ScanIgnoreWhitespace()
+	if tok != OFFSET {
+		return 0, newParseError(tokstr(tok, lit), []string{"OFFSET"}, pos)
+	}
+	tok, pos, lit = p.ScanIgnoreWhitespace()
+	if tok != NUMBER {
+		return 0, newParseError(tokstr(tok, lit), []string{"NUMBER"}, pos)
+	}
+	offset, err := strconv.Atoi(lit)
+	if err != nil {
+		return 0, newParseError(tokstr(tok, lit), []string{"NUMBER"}, pos)
+	}
+	return offset, nil
+}
+
 // Parse parses sparql query into syntax tree.
 func (p *Parser) Parse() (*QueryTree, *ParseError) {
 	prologue, err := p.parsePrologue()
@@ -272,15 +616,54 @@ func (p *Parser) Parse() (*QueryTree, *ParseError) {
 	if err != nil {
 		return nil, err
 	}
-	orderby, err := p.parseOrderBy()
+	groupby, err := p.parseGroupBy()
 	if err != nil {
 		return nil, err
 	}
-	limit, err := p.parseLimit()
+	orderby, err := p.parseOrderBy()
 	if err != nil {
 		return nil, err
 	}
-	return &QueryTree{P: prologue, S: sel, W: where, O: orderby, L: limit}, nil
+	// LIMIT and OFFSET can be in any order, each at most once, and end the
+	// query.
+	var limit, offset int
+	hasLimit, hasOffset := false, false
+	for {
+		tok, pos, lit := p.ScanIgnoreWhitespace()
+		if tok == EOF {
+			break
+		}
+		p.Unscan()
+		switch {
+		case tok == LIMIT && !hasLimit:
+			limit, err = p.parseLimit()
+			hasLimit = true
+		case tok == OFFSET && !hasOffset:
+			offset, err = p.parseOffset()
+			hasOffset = true
+		default:
+			expected := []string{}
+			if !hasLimit {
+				expected = append(expected, "LIMIT")
+			}
+			if !hasOffset {
+				expected = append(expected, "OFFSET")
+			}
+			return nil, newParseError(tokstr(tok, lit), append(expected, "EOF"), pos)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &QueryTree{
+		P:      prologue,
+		S:      sel,
+		W:      where,
+		G:      groupby,
+		O:      orderby,
+		L:      limit,
+		Offset: offset,
+	}, nil
 }
 
 // Scan returns the next token from the underlying scanner.
@@ -18,6 +18,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/datacommonsorg/mixer/internal/translator/types"
 	"github.com/go-test/deep"
 )
 
@@ -73,15 +74,52 @@ func TestParseSelect(t *testing.T) {
 		},
 		{
 			"SELECT DISTINCT ?name ?person",
-			&Select{[]string{"?name", "?person"}, true},
+			&Select{Variable: []string{"?name", "?person"}, Distinct: true},
 			false,
 		},
 		{
 			`SELECT ?name ?person
 			WHERE {}`,
-			&Select{[]string{"?name", "?person"}, false},
+			&Select{Variable: []string{"?name", "?person"}},
 			false,
 		},
+		{
+			"SELECT ?state (COUNT(DISTINCT ?county) AS ?count) (SUM(?pop) AS ?total) WHERE",
+			&Select{
+				Variable: []string{"?state", "?count", "?total"},
+				Aggregates: []*types.Aggregate{
+					{
+						Expr:  &types.Expr{Op: "count", Args: []*types.Expr{{Var: "?county"}}, Distinct: true},
+						Alias: "?count",
+					},
+					{
+						Expr:  &types.Expr{Op: "sum", Args: []*types.Expr{{Var: "?pop"}}},
+						Alias: "?total",
+					},
+				},
+			},
+			false,
+		},
+		{
+			"SELECT (COUNT(*) AS ?count) WHERE",
+			&Select{
+				Variable: []string{"?count"},
+				Aggregates: []*types.Aggregate{
+					{Expr: &types.Expr{Op: "count", Args: []*types.Expr{}}, Alias: "?count"},
+				},
+			},
+			false,
+		},
+		{
+			"SELECT (SUM(*) AS ?total) WHERE",
+			nil,
+			true,
+		},
+		{
+			"SELECT (COUNT(?a) ?count) WHERE",
+			nil,
+			true,
+		},
 	} {
 		result, err := NewParser(strings.NewReader(c.query)).parseSelect()
 		if c.wantErr {
@@ -109,12 +147,12 @@ func TestParseWhere(t *testing.T) {
 		},
 		{
 			"Where {?person rdf:name ?name}",
-			&Where{[]Triple{{"?person", "rdf:name", []string{"?name"}}}},
+			&Where{Triples: []Triple{{"?person", "rdf:name", []string{"?name"}}}},
 			false,
 		},
 		{
 			"Where {?person rdf:name ?name . ?person rdf:address ?address }",
-			&Where{[]Triple{
+			&Where{Triples: []Triple{
 				{"?person", "rdf:name", []string{"?name"}},
 				{"?person", "rdf:address", []string{"?address"}},
 			}},
@@ -122,11 +160,113 @@ func TestParseWhere(t *testing.T) {
 		},
 		{
 			`Where { ?a name ("San Jose, CA" "SJ in CA") }`,
-			&Where{[]Triple{
+			&Where{Triples: []Triple{
 				{"?a", "name", []string{"\"San Jose, CA\"", "\"SJ in CA\""}},
 			}},
 			false,
 		},
+		{
+			`Where {
+				?a name ?name .
+				?a population ?pop .
+				FILTER (?pop > 100 && !(?name = "X"))
+				FILTER regex(?name, "^San", "i")
+			}`,
+			&Where{
+				Triples: []Triple{
+					{"?a", "name", []string{"?name"}},
+					{"?a", "population", []string{"?pop"}},
+				},
+				Filters: []*types.Expr{
+					{Op: "&&", Args: []*types.Expr{
+						{Op: ">", Args: []*types.Expr{{Var: "?pop"}, {Value: "100"}}},
+						{Op: "!", Args: []*types.Expr{
+							{Op: "=", Args: []*types.Expr{{Var: "?name"}, {Value: `"X"`}}},
+						}},
+					}},
+					{Op: "regex", Args: []*types.Expr{{Var: "?name"}, {Value: `"^San"`}, {Value: `"i"`}}},
+				},
+			},
+			false,
+		},
+		{
+			`Where {
+				?a typeOf State .
+				OPTIONAL { ?a name ?name . FILTER (?name IN ("A", "B")) }
+			}`,
+			&Where{
+				Triples: []Triple{{"?a", "typeOf", []string{"State"}}},
+				Optional: []*Where{{
+					Triples: []Triple{{"?a", "name", []string{"?name"}}},
+					Filters: []*types.Expr{
+						{Op: "IN", Args: []*types.Expr{{Var: "?name"}, {Value: `"A"`}, {Value: `"B"`}}},
+					},
+				}},
+			},
+			false,
+		},
+		{
+			`Where {
+				?a name ?name .
+				{ ?a typeOf State } UNION { ?a typeOf Country }
+			}`,
+			&Where{
+				Triples: []Triple{{"?a", "name", []string{"?name"}}},
+				Union: []*Where{
+					{Triples: []Triple{{"?a", "typeOf", []string{"State"}}}},
+					{Triples: []Triple{{"?a", "typeOf", []string{"Country"}}}},
+				},
+			},
+			false,
+		},
+		{
+			`Where {
+				?a name ?name FILTER (?name = "X")
+				OPTIONAL { ?a population ?pop FILTER (?pop > 100) }
+				?a typeOf State
+				{ ?a typeOf State } UNION { ?a typeOf Country }
+			}`,
+			&Where{
+				Triples: []Triple{
+					{"?a", "name", []string{"?name"}},
+					{"?a", "typeOf", []string{"State"}},
+				},
+				Filters: []*types.Expr{
+					{Op: "=", Args: []*types.Expr{{Var: "?name"}, {Value: `"X"`}}},
+				},
+				Optional: []*Where{{
+					Triples: []Triple{{"?a", "population", []string{"?pop"}}},
+					Filters: []*types.Expr{
+						{Op: ">", Args: []*types.Expr{{Var: "?pop"}, {Value: "100"}}},
+					},
+				}},
+				Union: []*Where{
+					{Triples: []Triple{{"?a", "typeOf", []string{"State"}}}},
+					{Triples: []Triple{{"?a", "typeOf", []string{"Country"}}}},
+				},
+			},
+			false,
+		},
+		{
+			"Where { ?a name FILTER (?name = \"X\") }",
+			nil,
+			true,
+		},
+		{
+			"Where { { ?a typeOf State } }",
+			nil,
+			true,
+		},
+		{
+			"Where { OPTIONAL { OPTIONAL { ?a name ?name } } }",
+			nil,
+			true,
+		},
+		{
+			"Where { ?a name ?name . FILTER (?name = ) }",
+			nil,
+			true,
+		},
 	} {
 		result, err := NewParser(strings.NewReader(c.query)).parseWhere()
 		if c.wantErr {
@@ -141,10 +281,59 @@ func TestParseWhere(t *testing.T) {
 	}
 }
 
+func TestParseGroupBy(t *testing.T) {
+	for _, c := range []struct {
+		query   string
+		want    *Groupby
+		wantErr bool
+	}{
+		{
+			"Order By ?name",
+			nil,
+			false,
+		},
+		{
+			"Group By 3",
+			nil,
+			true,
+		},
+		{
+			"Group By ?state ?type",
+			&Groupby{Variable: []string{"?state", "?type"}},
+			false,
+		},
+		{
+			"Group By ?state HAVING (COUNT(?county) > 10) (?count < 100)",
+			&Groupby{
+				Variable: []string{"?state"},
+				Having: []*types.Expr{
+					{Op: ">", Args: []*types.Expr{
+						{Op: "count", Args: []*types.Expr{{Var: "?county"}}},
+						{Value: "10"},
+					}},
+					{Op: "<", Args: []*types.Expr{{Var: "?count"}, {Value: "100"}}},
+				},
+			},
+			false,
+		},
+	} {
+		result, err := NewParser(strings.NewReader(c.query)).parseGroupBy()
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("parseGroupBy(%s) = nil, want error", c.query)
+			}
+			continue
+		}
+		if diff := deep.Equal(c.want, result); diff != nil {
+			t.Errorf("Unexpected diff %v", diff)
+		}
+	}
+}
+
 func TestParseOrderBy(t *testing.T) {
 	for _, c := range []struct {
 		query   string
-		want    *Orderby
+		want    []*Orderby
 		wantErr bool
 	}{
 		{
@@ -154,19 +343,29 @@ func TestParseOrderBy(t *testing.T) {
 		},
 		{
 			"Order By ?name",
-			&Orderby{"?name", true},
+			[]*Orderby{{"?name", true}},
 			false,
 		},
 		{
 			"Order By ASC(?age)",
-			&Orderby{"?age", true},
+			[]*Orderby{{"?age", true}},
 			false,
 		},
 		{
 			"Order By DESC(?pop)",
-			&Orderby{"?pop", false},
+			[]*Orderby{{"?pop", false}},
+			false,
+		},
+		{
+			"Order By ?state DESC(?pop) ASC(?name) LIMIT 10",
+			[]*Orderby{{"?state", true}, {"?pop", false}, {"?name", true}},
 			false,
 		},
+		{
+			"Order By DESC ?pop",
+			nil,
+			true,
+		},
 	} {
 		result, err := NewParser(strings.NewReader(c.query)).parseOrderBy()
 		if c.wantErr {
@@ -235,8 +434,8 @@ func TestParseTree(t *testing.T) {
 			`,
 			&QueryTree{
 				P: &Prologue{Base: "<http://schema.org/>", Prefix: map[string]string{}},
-				S: &Select{[]string{"?dcid"}, true},
-				W: &Where{[]Triple{
+				S: &Select{Variable: []string{"?dcid"}, Distinct: true},
+				W: &Where{Triples: []Triple{
 					{"?p", "typeOf", []string{"Place"}},
 					{"?p", "subType", []string{"City"}},
 					{"?p", "name", []string{"\"San Jose\""}},
@@ -256,15 +455,74 @@ func TestParseTree(t *testing.T) {
 			`,
 			&QueryTree{
 				P: &Prologue{Base: "<http://schema.org/>", Prefix: map[string]string{}},
-				S: &Select{[]string{"?a"}, false},
-				W: &Where{[]Triple{
+				S: &Select{Variable: []string{"?a"}},
+				W: &Where{Triples: []Triple{
 					{"?a", "name", []string{"\"San Jose, CA\"", "\"SJ in CA\""}},
 				}},
-				O: &Orderby{"?a", true},
+				O: []*Orderby{{"?a", true}},
 				L: 10,
 			},
 			false,
 		},
+		{
+			`SELECT ?a
+			 WHERE {
+			 	?a typeOf State
+			 }
+			 ORDER BY ?a OFFSET 20 LIMIT 10
+			`,
+			&QueryTree{
+				P: &Prologue{Prefix: map[string]string{}},
+				S: &Select{Variable: []string{"?a"}},
+				W: &Where{Triples: []Triple{
+					{"?a", "typeOf", []string{"State"}},
+				}},
+				O:      []*Orderby{{"?a", true}},
+				L:      10,
+				Offset: 20,
+			},
+			false,
+		},
+		{
+			`SELECT ?a
+			 WHERE {
+			 	?a typeOf State
+			 }
+			 OFFSET ten
+			`,
+			nil,
+			true,
+		},
+		{
+			`SELECT ?a
+			 WHERE {
+			 	?a typeOf State
+			 }
+			 LIMIT 10 LIMIT 5
+			`,
+			nil,
+			true,
+		},
+		{
+			`SELECT ?a
+			 WHERE {
+			 	?a typeOf State
+			 }
+			 LIMIT 1 OFFSET 2 LIMIT 3
+			`,
+			nil,
+			true,
+		},
+		{
+			`SELECT ?a
+			 WHERE {
+			 	?a typeOf State
+			 }
+			 LIMIT 1 ?a
+			`,
+			nil,
+			true,
+		},
 	} {
 		result, err := NewParser(strings.NewReader(c.query)).Parse()
 		if c.wantErr {
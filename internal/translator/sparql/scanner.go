@@ -74,9 +74,33 @@ func (s *Scanner) Scan() (tok Token, pos Pos, lit string) {
 		return HASH, pos, "#"
 	case '=':
 		return EQ, pos, ""
+	case '!':
+		if ch1, _ := s.r.read(); ch1 == '=' {
+			return NEQ, pos, ""
+		}
+		s.r.unread()
+		return NOT, pos, ""
+	case '&':
+		if ch1, _ := s.r.read(); ch1 == '&' {
+			return AND, pos, ""
+		}
+		s.r.unread()
+	case '|':
+		if ch1, _ := s.r.read(); ch1 == '|' {
+			return OR, pos, ""
+		}
+		s.r.unread()
 	case '<':
+		if ch1, _ := s.r.read(); ch1 == '=' {
+			return LTE, pos, "<="
+		}
+		s.r.unread()
 		return LT, pos, "<"
 	case '>':
+		if ch1, _ := s.r.read(); ch1 == '=' {
+			return GTE, pos, ">="
+		}
+		s.r.unread()
 		return GT, pos, ">"
 	case '(':
 		return LPAREN, pos, ""
@@ -90,6 +114,8 @@ func (s *Scanner) Scan() (tok Token, pos Pos, lit string) {
 		return COMMA, pos, ""
 	case ';':
 		return SEMICOLON, pos, ""
+	case '*':
+		return MUL, pos, "*"
 	}
 	return ILLEGAL, pos, string(ch0)
 }
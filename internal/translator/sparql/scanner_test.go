@@ -47,8 +47,16 @@ func TestScanSimple(t *testing.T) {
 		{s: `OR`, tok: OR},
 		{s: `or`, tok: OR},
 
+		{s: `&&`, tok: AND},
+		{s: `||`, tok: OR},
+		{s: `& `, tok: ILLEGAL, lit: "&"},
+
 		{s: `=`, tok: EQ},
-		{s: `! `, tok: ILLEGAL, lit: "!"},
+		{s: `!=`, tok: NEQ},
+		{s: `! `, tok: NOT},
+		{s: `<=`, tok: LTE, lit: "<="},
+		{s: `>=`, tok: GTE, lit: ">="},
+		{s: `> `, tok: GT, lit: ">"},
 
 		// Misc tokens
 		{s: `(`, tok: LPAREN},
@@ -94,9 +102,11 @@ func TestScanSimple(t *testing.T) {
 		{s: `FROM`, tok: FROM},
 		{s: `IN`, tok: IN},
 		{s: `LIMIT`, tok: LIMIT},
+		{s: `OPTIONAL`, tok: OPTIONAL},
 		{s: `ORDER`, tok: ORDER},
 		{s: `PREFIX`, tok: PREFIX},
 		{s: `SELECT`, tok: SELECT},
+		{s: `UNION`, tok: UNION},
 		{s: `WHERE`, tok: WHERE},
 		{s: `seLECT`, tok: SELECT}, // case insensitive
 	}
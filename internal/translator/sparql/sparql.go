@@ -37,8 +37,45 @@ func ParseQuery(queryString string) ([]types.Node, []*types.Query, *types.QueryO
 		nodes = append(nodes, types.NewNode(v))
 	}
 
+	queries := toQueries(queryTree.W.Triples)
+	opts.Filters = queryTree.W.Filters
+	for _, w := range queryTree.W.Optional {
+		opts.Optionals = append(opts.Optionals, toGraphPattern(w))
+	}
+	for _, w := range queryTree.W.Union {
+		opts.Unions = append(opts.Unions, toGraphPattern(w))
+	}
+	opts.Aggregates = queryTree.S.Aggregates
+	if queryTree.G != nil {
+		opts.GroupBy = queryTree.G.Variable
+		opts.Having = queryTree.G.Having
+	}
+	if len(opts.Aggregates) > 0 || len(opts.GroupBy) > 0 {
+		// Variables that are selected directly need to be grouped.
+		grouped := map[string]struct{}{}
+		for _, v := range opts.GroupBy {
+			grouped[v] = struct{}{}
+		}
+		for _, agg := range opts.Aggregates {
+			grouped[agg.Alias] = struct{}{}
+		}
+		for _, v := range queryTree.S.Variable {
+			if _, ok := grouped[v]; !ok {
+				return nil, nil, nil, status.Errorf(
+					codes.InvalidArgument, "Variable %s is selected but not in GROUP BY", v)
+			}
+		}
+	}
+	for _, o := range queryTree.O {
+		opts.Orderby = append(opts.Orderby, types.OrderKey{Variable: o.Variable, ASC: o.ASC})
+	}
+	opts.Offset = queryTree.Offset
+	return nodes, queries, &opts, nil
+}
+
+func toQueries(triples []Triple) []*types.Query {
 	queries := []*types.Query{}
-	for _, t := range queryTree.W.Triples {
+	for _, t := range triples {
 		var query *types.Query
 		if len(t.Objs) == 1 {
 			obj := t.Objs[0]
@@ -52,9 +89,9 @@ func ParseQuery(queryString string) ([]types.Node, []*types.Query, *types.QueryO
 		}
 		queries = append(queries, query)
 	}
-	if queryTree.O != nil {
-		opts.Orderby = queryTree.O.Variable
-		opts.ASC = queryTree.O.ASC
-	}
-	return nodes, queries, &opts, nil
+	return queries
+}
+
+func toGraphPattern(w *Where) *types.GraphPattern {
+	return &types.GraphPattern{Queries: toQueries(w.Triples), Filters: w.Filters}
 }
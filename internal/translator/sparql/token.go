@@ -42,10 +42,14 @@ const (
 	// AND and following are Sparql operators.
 	AND // AND
 	OR  // OR
+	NOT // !
 	EQ  // =
+	NEQ // !=
 
 	LT        // <
+	LTE       // <=
 	GT        // >
+	GTE       // >=
 	LPAREN    // (
 	RPAREN    // )
 	LBRAC     // {
@@ -54,9 +58,11 @@ const (
 	SEMICOLON // ;
 	DOT       //.
 	HASH      // #
+	MUL       // *
 
 	keywordBeg
-	// ASC and following are Sparql keywords.
+	// AS and following are Sparql keywords.
+	AS
 	ASC
 	BASE
 	BY
@@ -64,11 +70,16 @@ const (
 	DISTINCT
 	FILTER
 	FROM
+	GROUP
+	HAVING
 	IN
 	LIMIT
+	OFFSET
+	OPTIONAL
 	ORDER
 	PREFIX
 	SELECT
+	UNION
 	WHERE
 	keywordEnd
 )
@@ -91,11 +102,15 @@ var (
 
 		AND: "AND",
 		OR:  "OR",
+		NOT: "!",
 
-		EQ: "=",
+		EQ:  "=",
+		NEQ: "!=",
 
 		LT:        "<",
+		LTE:       "<=",
 		GT:        ">",
+		GTE:       ">=",
 		LPAREN:    "(",
 		RPAREN:    ")",
 		LBRAC:     "{",
@@ -104,7 +119,9 @@ var (
 		SEMICOLON: ";",
 		DOT:       ".",
 		HASH:      ".",
+		MUL:       "*",
 
+		AS:       "AS",
 		ASC:      "ASC",
 		BASE:     "BASE",
 		BY:       "BY",
@@ -112,11 +129,16 @@ var (
 		DISTINCT: "DISTINCT",
 		FILTER:   "FILTER",
 		FROM:     "FROM",
+		GROUP:    "GROUP",
+		HAVING:   "HAVING",
 		IN:       "IN",
 		LIMIT:    "LIMIT",
+		OFFSET:   "OFFSET",
+		OPTIONAL: "OPTIONAL",
 		ORDER:    "ORDER",
 		PREFIX:   "PREFIX",
 		SELECT:   "SELECT",
+		UNION:    "UNION",
 		WHERE:    "WHERE",
 	}
 
@@ -150,6 +172,8 @@ func (tok Token) Precedence() int {
 		return 1
 	case AND:
 		return 2
+	case EQ, NEQ, LT, LTE, GT, GTE, IN:
+		return 3
 	}
 	return 0
 }
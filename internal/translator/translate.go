@@ -16,6 +16,7 @@ package translator
 
 import (
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -645,6 +646,21 @@ func getSQL(
 	provCols := map[types.Column]int{}
 	provList := []types.Column{}
 	pc := len(nodes)
+	resolve := func(v string) string {
+		n := types.NewNode(v)
+		if str, ok := constNode[n]; ok {
+			return strconv.Quote(str)
+		}
+		for _, c := range constraints {
+			if n == c.RHS {
+				return fmt.Sprintf("%s.%s", c.LHS.Table.Alias(), c.LHS.Name)
+			}
+		}
+		return "NULL"
+	}
+	aggregates := aggregateByAlias(opts)
+	// Provenance columns can not be selected from grouped rows.
+	queryProv := provInfo.query && !isGrouped(opts)
 	sql := "SELECT "
 	if opts.Distinct {
 		sql += "DISTINCT "
@@ -653,16 +669,27 @@ func getSQL(
 		if idx != 0 {
 			sql += ",\n"
 		}
+		if agg, ok := aggregates[n.Alias]; ok {
+			aggSQL, err := filterSQL(agg.Expr, resolve)
+			if err != nil {
+				return "", nil, err
+			}
+			sql += fmt.Sprintf("%s AS %s", aggSQL, sqlName(n.Alias))
+			continue
+		}
 		if str, ok := constNode[n]; ok {
 			sql += fmt.Sprintf(`"%s"`, str)
+			continue
 		}
+		found := false
 		for _, c := range constraints {
 			if n == c.RHS {
+				found = true
 				sql += fmt.Sprintf("%s.%s AS %s",
 					c.LHS.Table.Alias(),
 					c.LHS.Name,
-					strings.TrimPrefix(strings.ReplaceAll(n.Alias, "/", "_"), "?"))
-				if provInfo.query {
+					sqlName(n.Alias))
+				if queryProv {
 					if provCol, ok := provInfo.tableProv[c.LHS.Table.Name]; ok {
 						provCol.Table.ID = c.LHS.Table.ID
 						if i, ok := provCols[provCol]; ok {
@@ -678,6 +705,10 @@ func getSQL(
 				break
 			}
 		}
+		// The node is not bound in this query, which happens in UNION branches.
+		if !found {
+			sql += fmt.Sprintf("NULL AS %s", sqlName(n.Alias))
+		}
 	}
 	for i, p := range provList {
 		sql += ",\n" + fmt.Sprintf("%s.%s AS prov%d", p.Table.Alias(), p.Name, i)
@@ -815,48 +846,614 @@ func getSQL(
 		return strings.Compare(
 			whereConstraints[i].LHS.String(), whereConstraints[j].LHS.String()) < 0
 	})
-	for idx, c := range whereConstraints {
-		if idx == 0 {
-			sql += "WHERE "
-		} else if idx != len(whereConstraints) {
-			sql += "AND "
-		}
+	conditions := []string{}
+	for _, c := range whereConstraints {
 		switch v := c.RHS.(type) {
 		case types.Column:
-			sql += fmt.Sprintf("%s.%s = %s.%s\n", c.LHS.Table.Alias(), c.LHS.Name, v.Table.Alias(), v.Name)
+			conditions = append(conditions, fmt.Sprintf(
+				"%s.%s = %s.%s", c.LHS.Table.Alias(), c.LHS.Name, v.Table.Alias(), v.Name))
 		case string:
 			// Before we have spanner table reflection, need to hardcode check here.
 			// But the user should really have quote for strings.
 			useQuote := strings.Contains(c.LHS.Table.Name, tmcf.Triple)
-			sql += fmt.Sprintf("%s.%s = %s\n", c.LHS.Table.Alias(), c.LHS.Name, addQuote(v, useQuote))
+			conditions = append(conditions, fmt.Sprintf(
+				"%s.%s = %s", c.LHS.Table.Alias(), c.LHS.Name, addQuote(v, useQuote)))
 		case []string:
 			strs := []string{}
 			for _, s := range v {
 				strs = append(strs, addQuote(s))
 			}
-			sql += fmt.Sprintf("%s.%s IN (%s)\n", c.LHS.Table.Alias(), c.LHS.Name, strings.Join(strs, ", "))
+			conditions = append(conditions, fmt.Sprintf(
+				"%s.%s IN (%s)", c.LHS.Table.Alias(), c.LHS.Name, strings.Join(strs, ", ")))
 		}
 	}
-	if opts.Orderby != "" {
-		sql += fmt.Sprintf(
-			"ORDER BY %s", strings.TrimPrefix(strings.ReplaceAll(opts.Orderby, "/", "_"), "?"))
-		if opts.ASC {
-			sql += " ASC\n"
-		} else {
-			sql += " DESC\n"
+	for _, f := range opts.Filters {
+		cond, err := filterSQL(f, resolve)
+		if err != nil {
+			return "", nil, err
 		}
+		conditions = append(conditions, cond)
 	}
-	if opts.Limit > 0 {
-		sql += fmt.Sprintf("LIMIT %d\n", opts.Limit)
+	sql += whereSQL(conditions)
+	group, err := groupSQL(opts, resolve)
+	if err != nil {
+		return "", nil, err
 	}
+	sql += group
+	sql += orderLimitSQL(opts)
 	return sql, prov, nil
 }
 
+func sqlName(alias string) string {
+	return strings.TrimPrefix(strings.ReplaceAll(alias, "/", "_"), "?")
+}
+
+func whereSQL(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+	return "WHERE " + strings.Join(conditions, "\nAND ") + "\n"
+}
+
+func orderLimitSQL(opts *types.QueryOptions) string {
+	sql := ""
+	if len(opts.Orderby) > 0 {
+		keys := []string{}
+		for _, key := range opts.Orderby {
+			if key.ASC {
+				keys = append(keys, sqlName(key.Variable)+" ASC")
+			} else {
+				keys = append(keys, sqlName(key.Variable)+" DESC")
+			}
+		}
+		sql += fmt.Sprintf("ORDER BY %s\n", strings.Join(keys, ", "))
+	}
+	limit := opts.Limit
+	// OFFSET can only be used together with LIMIT.
+	if limit <= 0 && opts.Offset > 0 {
+		limit = math.MaxInt64
+	}
+	if limit > 0 {
+		sql += fmt.Sprintf("LIMIT %d", limit)
+		if opts.Offset > 0 {
+			sql += fmt.Sprintf(" OFFSET %d", opts.Offset)
+		}
+		sql += "\n"
+	}
+	return sql
+}
+
+func isGrouped(opts *types.QueryOptions) bool {
+	return len(opts.Aggregates) > 0 || len(opts.GroupBy) > 0
+}
+
+func aggregateByAlias(opts *types.QueryOptions) map[string]*types.Aggregate {
+	result := map[string]*types.Aggregate{}
+	for _, agg := range opts.Aggregates {
+		result[agg.Alias] = agg
+	}
+	return result
+}
+
+// groupSQL gets the GROUP BY and HAVING clauses. HAVING conditions can refer to
+// aggregates by their alias.
+func groupSQL(opts *types.QueryOptions, resolve func(string) string) (string, error) {
+	sql := ""
+	if len(opts.GroupBy) > 0 {
+		cols := []string{}
+		for _, v := range opts.GroupBy {
+			cols = append(cols, resolve(v))
+		}
+		sql += fmt.Sprintf("GROUP BY %s\n", strings.Join(cols, ", "))
+	}
+	aggregates := aggregateByAlias(opts)
+	conditions := []string{}
+	for _, h := range opts.Having {
+		cond, err := filterSQL(h, func(v string) string {
+			if _, ok := aggregates[v]; ok {
+				return sqlName(v)
+			}
+			return resolve(v)
+		})
+		if err != nil {
+			return "", err
+		}
+		conditions = append(conditions, cond)
+	}
+	if len(conditions) > 0 {
+		sql += "HAVING " + strings.Join(conditions, "\nAND ") + "\n"
+	}
+	return sql, nil
+}
+
+// groupedNodes gets the variables needed to compute the groups and aggregates.
+func groupedNodes(opts *types.QueryOptions) []types.Node {
+	aggregates := aggregateByAlias(opts)
+	vars := append([]string{}, opts.GroupBy...)
+	for _, agg := range opts.Aggregates {
+		vars = append(vars, agg.Expr.Vars()...)
+	}
+	for _, h := range opts.Having {
+		vars = append(vars, h.Vars()...)
+	}
+	result := []types.Node{}
+	seen := map[string]struct{}{}
+	for _, v := range vars {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		if _, ok := aggregates[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		result = append(result, types.NewNode(v))
+	}
+	return result
+}
+
+// filterFunctions maps supported Sparql filter functions to the GoogleSQL
+// format and the number of arguments.
+var filterFunctions = map[string]struct {
+	format string
+	args   int
+}{
+	"bound":     {"%s IS NOT NULL", 1},
+	"contains":  {"STRPOS(%s, %s) > 0", 2},
+	"lcase":     {"LOWER(%s)", 1},
+	"str":       {"CAST(%s AS STRING)", 1},
+	"strends":   {"ENDS_WITH(%s, %s)", 2},
+	"strstarts": {"STARTS_WITH(%s, %s)", 2},
+	"ucase":     {"UPPER(%s)", 1},
+}
+
+// filterSQL translates a filter expression to a GoogleSQL condition, where
+// resolve gives the column reference of a variable.
+func filterSQL(e *types.Expr, resolve func(string) string) (string, error) {
+	if e.Op == "" {
+		if e.Var != "" {
+			return resolve(e.Var), nil
+		}
+		if strings.HasPrefix(e.Value, `"`) {
+			return strconv.Quote(strings.TrimSuffix(strings.TrimPrefix(e.Value, `"`), `"`)), nil
+		}
+		return strings.ToUpper(e.Value), nil
+	}
+	// Regex flags are merged into the pattern.
+	if e.Op == "regex" && len(e.Args) == 3 {
+		pattern, flags := e.Args[1], e.Args[2]
+		if !strings.HasPrefix(pattern.Value, `"`) || !strings.HasPrefix(flags.Value, `"`) {
+			return "", status.Errorf(codes.InvalidArgument,
+				"regex pattern and flags should be string literals, got %s", e)
+		}
+		if f := strings.Trim(flags.Value, `"`); f != "" {
+			pattern = &types.Expr{Value: fmt.Sprintf(`"(?%s)%s`,
+				f, strings.TrimPrefix(pattern.Value, `"`))}
+		}
+		e = &types.Expr{Op: e.Op, Args: []*types.Expr{e.Args[0], pattern}}
+	}
+	args := []string{}
+	for _, arg := range e.Args {
+		sql, err := filterSQL(arg, resolve)
+		if err != nil {
+			return "", err
+		}
+		args = append(args, sql)
+	}
+	switch e.Op {
+	case "=", "!=", "<", "<=", ">", ">=":
+		return fmt.Sprintf("%s %s %s", args[0], e.Op, args[1]), nil
+	case "&&":
+		return fmt.Sprintf("(%s AND %s)", args[0], args[1]), nil
+	case "||":
+		return fmt.Sprintf("(%s OR %s)", args[0], args[1]), nil
+	case "!":
+		return fmt.Sprintf("NOT (%s)", args[0]), nil
+	case "IN":
+		return fmt.Sprintf("%s IN (%s)", args[0], strings.Join(args[1:], ", ")), nil
+	case "count", "sum", "avg", "min", "max":
+		arg := "*"
+		if len(args) == 1 {
+			arg = args[0]
+		}
+		if e.Distinct {
+			arg = "DISTINCT " + arg
+		}
+		return fmt.Sprintf("%s(%s)", strings.ToUpper(e.Op), arg), nil
+	case "regex":
+		if len(args) != 2 {
+			return "", status.Errorf(codes.InvalidArgument, "Invalid regex filter %s", e)
+		}
+		return fmt.Sprintf("REGEXP_CONTAINS(%s, %s)", args[0], args[1]), nil
+	}
+	f, ok := filterFunctions[e.Op]
+	if !ok {
+		return "", status.Errorf(codes.InvalidArgument, "Unsupported filter function %s", e.Op)
+	}
+	if len(args) != f.args {
+		return "", status.Errorf(codes.InvalidArgument,
+			"%s expects %d arguments, got %d", e.Op, f.args, len(args))
+	}
+	values := []interface{}{}
+	for _, arg := range args {
+		values = append(values, arg)
+	}
+	return fmt.Sprintf(f.format, values...), nil
+}
+
+// selectSQL gets the SELECT clause of a query over sub queries, where resolve
+// gives the column reference of a variable. Extra columns are selected after
+// the variables.
+func selectSQL(
+	nodes []types.Node, opts *types.QueryOptions, resolve func(string) string,
+	extra []string) (string, error) {
+	aggregates := aggregateByAlias(opts)
+	cols := []string{}
+	for _, n := range nodes {
+		ref := resolve(n.Alias)
+		if agg, ok := aggregates[n.Alias]; ok {
+			aggSQL, err := filterSQL(agg.Expr, resolve)
+			if err != nil {
+				return "", err
+			}
+			ref = aggSQL
+		}
+		cols = append(cols, fmt.Sprintf("%s AS %s", ref, sqlName(n.Alias)))
+	}
+	cols = append(cols, extra...)
+	sql := "SELECT "
+	if opts.Distinct {
+		sql += "DISTINCT "
+	}
+	return sql + strings.Join(cols, ",\n") + "\n", nil
+}
+
+// provSource is a sub query whose provenance columns are selected by an outer
+// query.
+type provSource struct {
+	alias string
+	t     *Translation
+}
+
+// provColumn gets the provenance column of a variable in the sub query.
+func (s provSource) provColumn(v string) (string, bool) {
+	for pc, idx := range s.t.Prov {
+		for _, i := range idx {
+			if s.t.Nodes[i].Alias == v {
+				return fmt.Sprintf("%s.prov%d", s.alias, pc-len(s.t.Nodes)), true
+			}
+		}
+	}
+	return "", false
+}
+
+// unionProv wraps each UNION branch to select one provenance column for each
+// node with provenance in any branch, so all branches have the same columns.
+// It sets the provenance columns of the result and returns the branch SQL.
+func unionProv(result *Translation, branches []*Translation) []string {
+	sources := []provSource{}
+	for i, b := range branches {
+		sources = append(sources, provSource{fmt.Sprintf("_branch%d", i), b})
+	}
+	slots := []int{}
+	for i, n := range result.Nodes {
+		for _, s := range sources {
+			if _, ok := s.provColumn(n.Alias); ok {
+				slots = append(slots, i)
+				break
+			}
+		}
+	}
+	sqls := []string{}
+	if len(slots) == 0 {
+		for _, b := range branches {
+			sqls = append(sqls, b.SQL)
+		}
+		return sqls
+	}
+	for k, i := range slots {
+		result.Prov[len(result.Nodes)+k] = []int{i}
+	}
+	for _, s := range sources {
+		cols := []string{}
+		for _, n := range result.Nodes {
+			cols = append(cols, fmt.Sprintf("%s.%s AS %s", s.alias, sqlName(n.Alias), sqlName(n.Alias)))
+		}
+		for k, i := range slots {
+			ref, ok := s.provColumn(result.Nodes[i].Alias)
+			if !ok {
+				ref = "NULL"
+			}
+			cols = append(cols, fmt.Sprintf("%s AS prov%d", ref, k))
+		}
+		sqls = append(sqls, fmt.Sprintf("SELECT %s\nFROM (\n%s) AS %s\n",
+			strings.Join(cols, ",\n"), s.t.SQL, s.alias))
+	}
+	return sqls
+}
+
+// queryNodes gets the variable nodes referenced by queries in order of
+// appearance.
+func queryNodes(queries []*types.Query) []types.Node {
+	result := []types.Node{}
+	seen := map[types.Node]struct{}{}
+	add := func(n types.Node) {
+		if _, ok := seen[n]; ok || !strings.HasPrefix(n.Alias, "?") {
+			return
+		}
+		seen[n] = struct{}{}
+		result = append(result, n)
+	}
+	for _, q := range queries {
+		add(q.Sub)
+		if n, ok := q.Obj.(types.Node); ok {
+			add(n)
+		}
+	}
+	return result
+}
+
+// validateVariables checks that the variables selected, filtered, ordered or
+// grouped on are bound by a query statement or an aggregate, so a misspelled
+// variable is not silently translated to NULL.
+func validateVariables(
+	nodes []types.Node, queries []*types.Query, opts *types.QueryOptions) error {
+	patterns := []*types.GraphPattern{{Queries: queries, Filters: opts.Filters}}
+	patterns = append(patterns, opts.Unions...)
+	patterns = append(patterns, opts.Optionals...)
+	bound := map[string]struct{}{}
+	for _, p := range patterns {
+		for _, n := range queryNodes(p.Queries) {
+			bound[n.Alias] = struct{}{}
+		}
+	}
+	for _, agg := range opts.Aggregates {
+		bound[agg.Alias] = struct{}{}
+	}
+	vars := []string{}
+	for _, n := range nodes {
+		vars = append(vars, n.Alias)
+	}
+	for _, p := range patterns {
+		for _, f := range p.Filters {
+			vars = append(vars, f.Vars()...)
+		}
+	}
+	for _, k := range opts.Orderby {
+		vars = append(vars, k.Variable)
+	}
+	vars = append(vars, opts.GroupBy...)
+	for _, agg := range opts.Aggregates {
+		vars = append(vars, agg.Expr.Vars()...)
+	}
+	for _, h := range opts.Having {
+		vars = append(vars, h.Vars()...)
+	}
+	for _, v := range vars {
+		if _, ok := bound[v]; !ok {
+			return status.Errorf(codes.InvalidArgument,
+				"variable %s is not bound by any triple or aggregate", v)
+		}
+	}
+	return nil
+}
+
 // Translate takes a datalog query and translates to GoogleSQL query based on schema mapping.
 func Translate(
 	mappings []*types.Mapping, nodes []types.Node, queries []*types.Query,
 	subTypeMap map[string]string, options ...*types.QueryOptions) (
 	*Translation, error) {
+	var queryOptions *types.QueryOptions
+	if len(options) > 0 {
+		queryOptions = options[0]
+	} else {
+		queryOptions = &types.QueryOptions{}
+	}
+	if err := validateVariables(nodes, queries, queryOptions); err != nil {
+		return nil, err
+	}
+	if len(queryOptions.Unions) > 0 {
+		if len(queryOptions.Optionals) > 0 {
+			return nil, status.Errorf(
+				codes.Unimplemented, "OPTIONAL and UNION in the same query is not supported")
+		}
+		return translateUnion(mappings, nodes, queries, subTypeMap, queryOptions)
+	}
+	if len(queryOptions.Optionals) > 0 {
+		return translateOptional(mappings, nodes, queries, subTypeMap, queryOptions)
+	}
+	return translate(mappings, nodes, queries, subTypeMap, queryOptions)
+}
+
+// translateUnion translates each UNION alternative together with the required
+// queries, and combines the results with UNION ALL.
+func translateUnion(
+	mappings []*types.Mapping, nodes []types.Node, queries []*types.Query,
+	subTypeMap map[string]string, opts *types.QueryOptions) (
+	*Translation, error) {
+	result := &Translation{Nodes: nodes, Prov: map[int][]int{}}
+	// When grouping, branches select the variables to group and aggregate on,
+	// and the groups are computed over the combined rows.
+	grouped := isGrouped(opts)
+	branchNodes := nodes
+	if grouped {
+		branchNodes = groupedNodes(opts)
+	}
+	branches := []*Translation{}
+	for _, union := range opts.Unions {
+		branchQueries := append(append([]*types.Query{}, queries...), union.Queries...)
+		branchOpts := &types.QueryOptions{
+			Db:       opts.Db,
+			Distinct: opts.Distinct && !grouped,
+			Filters:  append(append([]*types.Expr{}, opts.Filters...), union.Filters...),
+			// Provenance columns can not be selected from grouped rows.
+			Prov: opts.Prov && !grouped,
+		}
+		branch, err := translate(mappings, branchNodes, branchQueries, subTypeMap, branchOpts)
+		if err != nil {
+			return nil, err
+		}
+		branches = append(branches, branch)
+		result.Bindings = append(result.Bindings, branch.Bindings...)
+		result.Constraint = append(result.Constraint, branch.Constraint...)
+	}
+	if !grouped {
+		sqls := unionProv(result, branches)
+		op := "UNION ALL\n"
+		if opts.Distinct {
+			op = "UNION DISTINCT\n"
+		}
+		result.SQL = strings.Join(sqls, op) + orderLimitSQL(opts)
+		return result, nil
+	}
+
+	bound := map[string]string{}
+	for _, n := range branchNodes {
+		bound[n.Alias] = "_union." + sqlName(n.Alias)
+	}
+	resolve := func(v string) string {
+		if ref, ok := bound[v]; ok {
+			return ref
+		}
+		return "NULL"
+	}
+	sqls := []string{}
+	for _, b := range branches {
+		sqls = append(sqls, b.SQL)
+	}
+	sql, err := selectSQL(nodes, opts, resolve, nil)
+	if err != nil {
+		return nil, err
+	}
+	sql += fmt.Sprintf("FROM (\n%s) AS _union\n", strings.Join(sqls, "UNION ALL\n"))
+	group, err := groupSQL(opts, resolve)
+	if err != nil {
+		return nil, err
+	}
+	result.SQL = sql + group + orderLimitSQL(opts)
+	return result, nil
+}
+
+// translateOptional translates the required queries and each OPTIONAL pattern
+// into sub queries, which are then combined by LEFT JOIN on shared variables.
+func translateOptional(
+	mappings []*types.Mapping, nodes []types.Node, queries []*types.Query,
+	subTypeMap map[string]string, opts *types.QueryOptions) (
+	*Translation, error) {
+	// Provenance columns can not be selected from grouped rows.
+	queryProv := opts.Prov && !isGrouped(opts)
+	requiredNodes := queryNodes(queries)
+	required, err := translate(
+		mappings, requiredNodes, queries, subTypeMap,
+		&types.QueryOptions{Db: opts.Db, Prov: queryProv})
+	if err != nil {
+		return nil, err
+	}
+	result := &Translation{
+		Nodes:      nodes,
+		Bindings:   required.Bindings,
+		Constraint: required.Constraint,
+		Prov:       map[int][]int{},
+	}
+	// Column reference of each variable in the outer query.
+	bound := map[string]string{}
+	// Sub query that binds each variable, for its provenance column.
+	boundProv := map[string]provSource{}
+	for _, n := range requiredNodes {
+		bound[n.Alias] = "_main." + sqlName(n.Alias)
+		boundProv[n.Alias] = provSource{"_main", required}
+	}
+	from := fmt.Sprintf("FROM (\n%s) AS _main\n", required.SQL)
+	for i, optional := range opts.Optionals {
+		optionalNodes := queryNodes(optional.Queries)
+		optionalTypes, err := solver.GetNodeType(optional.Queries)
+		if err != nil {
+			return nil, err
+		}
+		// Shared nodes need their types to bind to the same tables.
+		optionalQueries := []*types.Query{}
+		for _, q := range queries {
+			if _, ok := optionalTypes[q.Sub.Alias]; !ok && q.IsTypeOf() {
+				for _, n := range optionalNodes {
+					if q.Sub == n {
+						optionalQueries = append(optionalQueries, q)
+						break
+					}
+				}
+			}
+		}
+		optionalQueries = append(optionalQueries, optional.Queries...)
+		t, err := translate(mappings, optionalNodes, optionalQueries, subTypeMap,
+			&types.QueryOptions{Db: opts.Db, Filters: optional.Filters, Prov: queryProv})
+		if err != nil {
+			return nil, err
+		}
+		result.Bindings = append(result.Bindings, t.Bindings...)
+		result.Constraint = append(result.Constraint, t.Constraint...)
+
+		alias := fmt.Sprintf("_opt%d", i)
+		conditions := []string{}
+		for _, n := range optionalNodes {
+			col := fmt.Sprintf("%s.%s", alias, sqlName(n.Alias))
+			if ref, ok := bound[n.Alias]; ok {
+				conditions = append(conditions, fmt.Sprintf("%s = %s", ref, col))
+			} else {
+				bound[n.Alias] = col
+				boundProv[n.Alias] = provSource{alias, t}
+			}
+		}
+		if len(conditions) == 0 {
+			conditions = append(conditions, "TRUE")
+		}
+		from += fmt.Sprintf("LEFT JOIN (\n%s) AS %s\nON %s\n",
+			t.SQL, alias, strings.Join(conditions, " AND "))
+	}
+
+	resolve := func(v string) string {
+		if ref, ok := bound[v]; ok {
+			return ref
+		}
+		return "NULL"
+	}
+	provCols := []string{}
+	if queryProv {
+		for i, n := range nodes {
+			s, ok := boundProv[n.Alias]
+			if !ok {
+				continue
+			}
+			if ref, ok := s.provColumn(n.Alias); ok {
+				result.Prov[len(nodes)+len(provCols)] = []int{i}
+				provCols = append(provCols, fmt.Sprintf("%s AS prov%d", ref, len(provCols)))
+			}
+		}
+	}
+	sql, err := selectSQL(nodes, opts, resolve, provCols)
+	if err != nil {
+		return nil, err
+	}
+	sql += from
+	conditions := []string{}
+	for _, f := range opts.Filters {
+		cond, err := filterSQL(f, resolve)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, cond)
+	}
+	sql += whereSQL(conditions)
+	group, err := groupSQL(opts, resolve)
+	if err != nil {
+		return nil, err
+	}
+	result.SQL = sql + group + orderLimitSQL(opts)
+	return result, nil
+}
+
+// translate translates queries without OPTIONAL or UNION patterns.
+func translate(
+	mappings []*types.Mapping, nodes []types.Node, queries []*types.Query,
+	subTypeMap map[string]string, queryOptions *types.QueryOptions) (
+	*Translation, error) {
 	funcDeps, err := solver.GetFuncDeps(mappings)
 	if err != nil {
 		return nil, err
@@ -893,18 +1490,8 @@ func Translate(
 		return nil, err
 	}
 
-	var (
-		queryProv    bool
-		queryOptions *types.QueryOptions
-	)
-	if len(options) > 0 {
-		queryOptions = options[0]
-		queryProv = options[0].Prov
-	} else {
-		queryOptions = &types.QueryOptions{}
-	}
-
-	sql, prov, err := getSQL(nodes, constraints, constNode, ProvInfo{queryProv, tableProv}, queryOptions)
+	sql, prov, err := getSQL(
+		nodes, constraints, constNode, ProvInfo{queryOptions.Prov, tableProv}, queryOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -231,7 +231,11 @@ func TestGetSQL(t *testing.T) {
 		constraints,
 		map[types.Node]string{},
 		ProvInfo{true, tableProv},
-		&types.QueryOptions{Limit: 20, Distinct: true, Orderby: "?dcid", ASC: true},
+		&types.QueryOptions{
+			Limit:    20,
+			Distinct: true,
+			Orderby:  []types.OrderKey{{Variable: "?dcid", ASC: true}},
+		},
 	)
 	if err != nil {
 		t.Fatalf("getSQL error: %s", err)
@@ -1253,3 +1257,389 @@ func TestStatVarObs(t *testing.T) {
 		}
 	}
 }
+
+func TestSparqlGraphPattern(t *testing.T) {
+	subTypeMap, err := solver.GetSubTypeMap("table_types.json")
+	if err != nil {
+		t.Fatalf("GetSubTypeMap() = %v", err)
+	}
+
+	mappings := testutil.ReadTestMapping(t, []string{
+		"testdata/test_mapping.mcf",
+	})
+	for _, c := range []struct {
+		name     string
+		queryStr string
+		wantSQL  string
+	}{
+		{
+			"filter",
+			`
+				SELECT ?name ?a
+				WHERE {
+				  ?a typeOf State .
+				  ?a name ?name .
+				  FILTER (regex(?name, "^Cal", "i") || ?name != "Texas")
+				}
+				LIMIT 10
+				`,
+			"SELECT _dc_v3_Place_0.name AS name,\n" +
+				"_dc_v3_Place_0.id AS a\n" +
+				"FROM `dc_v3.Place` AS _dc_v3_Place_0\n" +
+				"WHERE _dc_v3_Place_0.type = \"State\"\n" +
+				"AND (REGEXP_CONTAINS(_dc_v3_Place_0.name, \"(?i)^Cal\") OR _dc_v3_Place_0.name != \"Texas\")\n" +
+				"LIMIT 10\n",
+		},
+		{
+			"regex-without-flags",
+			`
+				SELECT ?a
+				WHERE {
+				  ?a typeOf State .
+				  ?a name ?name .
+				  FILTER regex(?name, "^Cal", "")
+				}
+				`,
+			"SELECT _dc_v3_Place_0.id AS a\n" +
+				"FROM `dc_v3.Place` AS _dc_v3_Place_0\n" +
+				"WHERE _dc_v3_Place_0.type = \"State\"\n" +
+				"AND REGEXP_CONTAINS(_dc_v3_Place_0.name, \"^Cal\")\n",
+		},
+		{
+			"order-offset",
+			`
+				SELECT ?name ?a
+				WHERE {
+				  ?a typeOf State .
+				  ?a name ?name .
+				}
+				ORDER BY DESC(?name) ?a
+				OFFSET 20
+				LIMIT 10
+				`,
+			"SELECT _dc_v3_Place_0.name AS name,\n" +
+				"_dc_v3_Place_0.id AS a\n" +
+				"FROM `dc_v3.Place` AS _dc_v3_Place_0\n" +
+				"WHERE _dc_v3_Place_0.type = \"State\"\n" +
+				"ORDER BY name DESC, a ASC\n" +
+				"LIMIT 10 OFFSET 20\n",
+		},
+		{
+			"optional",
+			`
+				SELECT ?name ?tz
+				WHERE {
+				  ?a typeOf State .
+				  ?a name ?name .
+				  OPTIONAL { ?a timezone ?tz }
+				}
+				ORDER BY ?name
+				`,
+			"SELECT _main.name AS name,\n" +
+				"_opt0.tz AS tz\n" +
+				"FROM (\n" +
+				"SELECT _dc_v3_Place_0.id AS a,\n" +
+				"_dc_v3_Place_0.name AS name\n" +
+				"FROM `dc_v3.Place` AS _dc_v3_Place_0\n" +
+				"WHERE _dc_v3_Place_0.type = \"State\"\n" +
+				") AS _main\n" +
+				"LEFT JOIN (\n" +
+				"SELECT _dc_v3_Place_0.id AS a,\n" +
+				"_dc_v3_Place_0.timezone AS tz\n" +
+				"FROM `dc_v3.Place` AS _dc_v3_Place_0\n" +
+				"WHERE _dc_v3_Place_0.type = \"State\"\n" +
+				") AS _opt0\n" +
+				"ON _main.a = _opt0.a\n" +
+				"ORDER BY name ASC\n",
+		},
+		{
+			"union",
+			`
+				SELECT DISTINCT ?name
+				WHERE {
+				  ?a name ?name .
+				  { ?a typeOf State } UNION { ?a typeOf Country . FILTER (strstarts(?name, "U")) }
+				}
+				`,
+			"SELECT DISTINCT _dc_v3_Place_0.name AS name\n" +
+				"FROM `dc_v3.Place` AS _dc_v3_Place_0\n" +
+				"WHERE _dc_v3_Place_0.type = \"State\"\n" +
+				"UNION DISTINCT\n" +
+				"SELECT DISTINCT _dc_v3_Place_0.name AS name\n" +
+				"FROM `dc_v3.Place` AS _dc_v3_Place_0\n" +
+				"WHERE _dc_v3_Place_0.type = \"Country\"\n" +
+				"AND STARTS_WITH(_dc_v3_Place_0.name, \"U\")\n",
+		},
+	} {
+		nodes, queries, opts, err := sparql.ParseQuery(c.queryStr)
+		if err != nil {
+			t.Errorf("ParseQuery error: %s", err)
+			continue
+		}
+		translation, err := Translate(mappings, nodes, queries, subTypeMap, opts)
+		if err != nil {
+			t.Errorf("Translate(%s) = %s", c.name, err)
+			continue
+		}
+		if diff := cmp.Diff(c.wantSQL, translation.SQL); diff != "" {
+			t.Errorf("getSQL unexpected sql diff for test %s, %v", c.name, diff)
+			continue
+		}
+	}
+}
+
+func TestSparqlAggregate(t *testing.T) {
+	subTypeMap, err := solver.GetSubTypeMap("table_types.json")
+	if err != nil {
+		t.Fatalf("GetSubTypeMap() = %v", err)
+	}
+
+	mappings := testutil.ReadTestMapping(t, []string{
+		"testdata/test_mapping.mcf",
+	})
+	for _, c := range []struct {
+		name     string
+		queryStr string
+		wantSQL  string
+		wantErr  bool
+	}{
+		{
+			"count-group-by",
+			`
+				SELECT ?state (COUNT(?county) AS ?count)
+				WHERE {
+				  ?county typeOf County .
+				  ?county containedInPlace ?state .
+				  ?state typeOf State .
+				}
+				GROUP BY ?state
+				HAVING (?count > 10)
+				ORDER BY DESC(?count)
+				LIMIT 5
+				`,
+			"SELECT _dc_v3_Place_1.id AS state,\n" +
+				"COUNT(_dc_v3_Place_0.id) AS count\n" +
+				"FROM `dc_v3.Place` AS _dc_v3_Place_0\n" +
+				"JOIN `dc_v3.Triple` AS _dc_v3_Triple_0\n" +
+				"ON _dc_v3_Place_0.id = _dc_v3_Triple_0.subject_id\n" +
+				"JOIN `dc_v3.Place` AS _dc_v3_Place_1\n" +
+				"ON _dc_v3_Triple_0.object_id = _dc_v3_Place_1.id\n" +
+				"WHERE _dc_v3_Place_0.type = \"County\"\n" +
+				"AND _dc_v3_Place_1.type = \"State\"\n" +
+				"AND _dc_v3_Triple_0.predicate = \"containedInPlace\"\n" +
+				"GROUP BY _dc_v3_Place_1.id\n" +
+				"HAVING count > 10\n" +
+				"ORDER BY count DESC\n" +
+				"LIMIT 5\n",
+			false,
+		},
+		{
+			"count-union",
+			`
+				SELECT (COUNT(DISTINCT ?name) AS ?n)
+				WHERE {
+				  ?a name ?name .
+				  { ?a typeOf State } UNION { ?a typeOf Country }
+				}
+				`,
+			"SELECT COUNT(DISTINCT _union.name) AS n\n" +
+				"FROM (\n" +
+				"SELECT _dc_v3_Place_0.name AS name\n" +
+				"FROM `dc_v3.Place` AS _dc_v3_Place_0\n" +
+				"WHERE _dc_v3_Place_0.type = \"State\"\n" +
+				"UNION ALL\n" +
+				"SELECT _dc_v3_Place_0.name AS name\n" +
+				"FROM `dc_v3.Place` AS _dc_v3_Place_0\n" +
+				"WHERE _dc_v3_Place_0.type = \"Country\"\n" +
+				") AS _union\n",
+			false,
+		},
+		{
+			"not-grouped",
+			`
+				SELECT ?name (COUNT(?a) AS ?count)
+				WHERE {
+				  ?a typeOf State .
+				  ?a name ?name .
+				}
+				`,
+			"",
+			true,
+		},
+	} {
+		nodes, queries, opts, err := sparql.ParseQuery(c.queryStr)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("ParseQuery(%s) = nil, want error", c.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseQuery error: %s", err)
+			continue
+		}
+		translation, err := Translate(mappings, nodes, queries, subTypeMap, opts)
+		if err != nil {
+			t.Errorf("Translate(%s) = %s", c.name, err)
+			continue
+		}
+		if diff := cmp.Diff(c.wantSQL, translation.SQL); diff != "" {
+			t.Errorf("getSQL unexpected sql diff for test %s, %v", c.name, diff)
+			continue
+		}
+	}
+}
+
+func TestSparqlProv(t *testing.T) {
+	subTypeMap, err := solver.GetSubTypeMap("table_types.json")
+	if err != nil {
+		t.Fatalf("GetSubTypeMap() = %v", err)
+	}
+
+	mappings := testutil.ReadTestMapping(t, []string{
+		"testdata/test_mapping.mcf",
+	})
+	for _, c := range []struct {
+		name     string
+		queryStr string
+		wantSQL  string
+		wantProv map[int][]int
+	}{
+		{
+			"optional",
+			`
+				SELECT ?name ?tz
+				WHERE {
+				  ?a typeOf State .
+				  ?a name ?name .
+				  OPTIONAL { ?a timezone ?tz }
+				}
+				`,
+			"SELECT _main.name AS name,\n" +
+				"_opt0.tz AS tz,\n" +
+				"_main.prov0 AS prov0,\n" +
+				"_opt0.prov0 AS prov1\n" +
+				"FROM (\n" +
+				"SELECT _dc_v3_Place_0.id AS a,\n" +
+				"_dc_v3_Place_0.name AS name,\n" +
+				"_dc_v3_Place_0.prov_id AS prov0\n" +
+				"FROM `dc_v3.Place` AS _dc_v3_Place_0\n" +
+				"WHERE _dc_v3_Place_0.type = \"State\"\n" +
+				") AS _main\n" +
+				"LEFT JOIN (\n" +
+				"SELECT _dc_v3_Place_0.id AS a,\n" +
+				"_dc_v3_Place_0.timezone AS tz,\n" +
+				"_dc_v3_Place_0.prov_id AS prov0\n" +
+				"FROM `dc_v3.Place` AS _dc_v3_Place_0\n" +
+				"WHERE _dc_v3_Place_0.type = \"State\"\n" +
+				") AS _opt0\n" +
+				"ON _main.a = _opt0.a\n",
+			map[int][]int{2: {0}, 3: {1}},
+		},
+		{
+			"union",
+			`
+				SELECT ?name
+				WHERE {
+				  ?a name ?name .
+				  { ?a typeOf State } UNION { ?a typeOf Country }
+				}
+				`,
+			"SELECT _branch0.name AS name,\n" +
+				"_branch0.prov0 AS prov0\n" +
+				"FROM (\n" +
+				"SELECT _dc_v3_Place_0.name AS name,\n" +
+				"_dc_v3_Place_0.prov_id AS prov0\n" +
+				"FROM `dc_v3.Place` AS _dc_v3_Place_0\n" +
+				"WHERE _dc_v3_Place_0.type = \"State\"\n" +
+				") AS _branch0\n" +
+				"UNION ALL\n" +
+				"SELECT _branch1.name AS name,\n" +
+				"_branch1.prov0 AS prov0\n" +
+				"FROM (\n" +
+				"SELECT _dc_v3_Place_0.name AS name,\n" +
+				"_dc_v3_Place_0.prov_id AS prov0\n" +
+				"FROM `dc_v3.Place` AS _dc_v3_Place_0\n" +
+				"WHERE _dc_v3_Place_0.type = \"Country\"\n" +
+				") AS _branch1\n",
+			map[int][]int{1: {0}},
+		},
+	} {
+		nodes, queries, opts, err := sparql.ParseQuery(c.queryStr)
+		if err != nil {
+			t.Errorf("ParseQuery error: %s", err)
+			continue
+		}
+		opts.Prov = true
+		translation, err := Translate(mappings, nodes, queries, subTypeMap, opts)
+		if err != nil {
+			t.Errorf("Translate(%s) = %s", c.name, err)
+			continue
+		}
+		if diff := cmp.Diff(c.wantSQL, translation.SQL); diff != "" {
+			t.Errorf("getSQL unexpected sql diff for test %s, %v", c.name, diff)
+			continue
+		}
+		if diff := cmp.Diff(c.wantProv, translation.Prov); diff != "" {
+			t.Errorf("getSQL unexpected prov diff for test %s, %v", c.name, diff)
+			continue
+		}
+	}
+}
+
+func TestTranslateUnboundVariable(t *testing.T) {
+	subTypeMap, err := solver.GetSubTypeMap("table_types.json")
+	if err != nil {
+		t.Fatalf("GetSubTypeMap() = %v", err)
+	}
+
+	mappings := testutil.ReadTestMapping(t, []string{
+		"testdata/test_mapping.mcf",
+	})
+	for _, c := range []struct {
+		name     string
+		queryStr string
+	}{
+		{
+			"select",
+			`
+				SELECT ?nmae
+				WHERE {
+				  ?a typeOf State .
+				  ?a name ?name .
+				}
+				`,
+		},
+		{
+			"filter",
+			`
+				SELECT ?name
+				WHERE {
+				  ?a typeOf State .
+				  ?a name ?name .
+				  FILTER (?nmae = "California")
+				}
+				`,
+		},
+		{
+			"order-by",
+			`
+				SELECT ?name
+				WHERE {
+				  ?a typeOf State .
+				  ?a name ?name .
+				}
+				ORDER BY ?nmae
+				`,
+		},
+	} {
+		nodes, queries, opts, err := sparql.ParseQuery(c.queryStr)
+		if err != nil {
+			t.Errorf("ParseQuery error: %s", err)
+			continue
+		}
+		if _, err := Translate(mappings, nodes, queries, subTypeMap, opts); err == nil {
+			t.Errorf("Translate(%s) = nil, want error", c.name)
+		}
+	}
+}
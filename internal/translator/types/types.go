@@ -34,10 +34,89 @@ type QueryOptions struct {
 	Db       string
 	Prov     bool
 	Distinct bool
-	Orderby  string
+	Orderby  []OrderKey
+	// Number of rows to skip.
+	Offset int
+	// Filters that each result row needs to satisfy.
+	Filters []*Expr
+	// Optional graph patterns, each translated into a LEFT JOIN.
+	Optionals []*GraphPattern
+	// Alternative graph patterns of a UNION. Each alternative is joined with the
+	// required query statements and the results are combined by UNION ALL.
+	Unions []*GraphPattern
+	// Aggregates in the SELECT clause, which are also in the selected nodes by
+	// their alias.
+	Aggregates []*Aggregate
+	// Variables to group by.
+	GroupBy []string
+	// Conditions on the groups.
+	Having []*Expr
+}
+
+// OrderKey is a sort key of query results.
+type OrderKey struct {
+	Variable string
 	ASC      bool
 }
 
+// Aggregate is an aggregate expression with an alias, like
+// (COUNT(?a) AS ?count).
+type Aggregate struct {
+	// Expr is an aggregate function call, like "count" or "sum".
+	Expr  *Expr
+	Alias string
+}
+
+// GraphPattern is a group of query statements and filters, as in the
+// OPTIONAL { } or UNION { } blocks of a Sparql query.
+type GraphPattern struct {
+	Queries []*Query
+	Filters []*Expr
+}
+
+// Expr represents a filter expression.
+//
+// A leaf expression holds either a variable in Var or a literal in Value.
+// String literals keep their double quotes. Otherwise Op is the operator or
+// function name applied to Args, like "=", "&&", "!", "IN" or "regex".
+// Distinct is only used by aggregate functions.
+type Expr struct {
+	Op       string
+	Args     []*Expr
+	Var      string
+	Value    string
+	Distinct bool
+}
+
+func (e *Expr) String() string {
+	if e.Op == "" {
+		if e.Var != "" {
+			return e.Var
+		}
+		return e.Value
+	}
+	args := []string{}
+	for _, arg := range e.Args {
+		args = append(args, arg.String())
+	}
+	if e.Distinct {
+		return fmt.Sprintf("%s(DISTINCT %s)", e.Op, strings.Join(args, ", "))
+	}
+	return fmt.Sprintf("%s(%s)", e.Op, strings.Join(args, ", "))
+}
+
+// Vars returns the variables referenced in the expression.
+func (e *Expr) Vars() []string {
+	if e.Var != "" {
+		return []string{e.Var}
+	}
+	result := []string{}
+	for _, arg := range e.Args {
+		result = append(result, arg.Vars()...)
+	}
+	return result
+}
+
 // Node represents a reference of a graph node in datalog query.
 type Node struct {
 	// Alias of a node in datalog query, should start with "?".
@@ -0,0 +1,153 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"strings"
+	"time"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+)
+
+var dateLayouts = []string{"2006-01-02", "2006-01", "2006"}
+
+// parseDate parses an ISO 8601 date of a year, month or day into the start of
+// the period.
+func parseDate(date string) (time.Time, bool) {
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, date); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// overlaps checks whether two dates of different granularity cover the same
+// period, like "2020" and "2020-07".
+func overlaps(a, b string) bool {
+	return strings.HasPrefix(a, b) || strings.HasPrefix(b, a)
+}
+
+// MatchDate gets the observation date of a series that matches a date in a
+// match mode, or "" when there is none.
+//
+// The date itself is always preferred. Otherwise a date of different
+// granularity in the same period, like "2020-07" for "2020", is the closest
+// one.
+func MatchDate(val map[string]float64, date string, match pb.DateMatch) string {
+	if _, ok := val[date]; ok {
+		return date
+	}
+	if date == "" || match == pb.DateMatch_DATE_MATCH_EXACT {
+		return ""
+	}
+	if match == pb.DateMatch_DATE_MATCH_SAME_YEAR {
+		if len(date) < 4 {
+			return ""
+		}
+		result := ""
+		for d := range val {
+			if strings.HasPrefix(d, date[:4]) && d > result {
+				result = d
+			}
+		}
+		return result
+	}
+	// The latest date in the same period, and the closest dates before and
+	// after the period.
+	same, before, after := "", "", ""
+	for d := range val {
+		switch {
+		case overlaps(d, date):
+			if match == pb.DateMatch_DATE_MATCH_NEAREST_AFTER {
+				if same == "" || d < same {
+					same = d
+				}
+			} else if d > same {
+				same = d
+			}
+		case d < date:
+			if d > before {
+				before = d
+			}
+		default:
+			if after == "" || d < after {
+				after = d
+			}
+		}
+	}
+	if same != "" {
+		return same
+	}
+	switch match {
+	case pb.DateMatch_DATE_MATCH_NEAREST_BEFORE:
+		return before
+	case pb.DateMatch_DATE_MATCH_NEAREST_AFTER:
+		return after
+	}
+	if before == "" || after == "" {
+		return before + after
+	}
+	q, ok := parseDate(date)
+	if !ok {
+		return ""
+	}
+	b, okBefore := parseDate(before)
+	a, okAfter := parseDate(after)
+	switch {
+	case !okBefore && !okAfter:
+		return ""
+	case !okBefore:
+		return after
+	case !okAfter:
+		return before
+	}
+	// On a tie, prefer the later date.
+	if a.Sub(q) <= q.Sub(b) {
+		return after
+	}
+	return before
+}
+
+// MatchDateAcrossSeries gets the observation date that matches a date in a
+// match mode across the values of several series, and the index of the series
+// it is from, or -1 when there is none.
+//
+// The date itself in any series is preferred. Otherwise the dates matched in
+// each series are matched again, so the closest one across series is picked.
+// Ties go to the earlier series, so the series should be sorted by rank.
+func MatchDateAcrossSeries(
+	vals []map[string]float64, date string, match pb.DateMatch,
+) (int, string) {
+	for i, val := range vals {
+		if _, ok := val[date]; ok {
+			return i, date
+		}
+	}
+	matched := map[string]float64{}
+	matchedIndex := map[string]int{}
+	for i, val := range vals {
+		if d := MatchDate(val, date, match); d != "" {
+			if _, ok := matched[d]; !ok {
+				matched[d] = val[d]
+				matchedIndex[d] = i
+			}
+		}
+	}
+	if d := MatchDate(matched, date, match); d != "" {
+		return matchedIndex[d], d
+	}
+	return -1, ""
+}
@@ -0,0 +1,85 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+)
+
+func TestMatchDate(t *testing.T) {
+	val := map[string]float64{
+		"2015":    1,
+		"2019":    2,
+		"2020-03": 3,
+		"2020-07": 4,
+		"2022-01": 5,
+	}
+	for _, c := range []struct {
+		date  string
+		match pb.DateMatch
+		want  string
+	}{
+		{"2019", pb.DateMatch_DATE_MATCH_EXACT, "2019"},
+		{"2020", pb.DateMatch_DATE_MATCH_EXACT, ""},
+		{"2020", pb.DateMatch_DATE_MATCH_SAME_YEAR, "2020-07"},
+		{"2020-01-15", pb.DateMatch_DATE_MATCH_SAME_YEAR, "2020-07"},
+		{"2018", pb.DateMatch_DATE_MATCH_SAME_YEAR, ""},
+		{"2020", pb.DateMatch_DATE_MATCH_NEAREST_BEFORE, "2020-07"},
+		{"2020", pb.DateMatch_DATE_MATCH_NEAREST_AFTER, "2020-03"},
+		{"2020-05", pb.DateMatch_DATE_MATCH_NEAREST_BEFORE, "2020-03"},
+		{"2020-05", pb.DateMatch_DATE_MATCH_NEAREST_AFTER, "2020-07"},
+		{"2014", pb.DateMatch_DATE_MATCH_NEAREST_BEFORE, ""},
+		{"2023", pb.DateMatch_DATE_MATCH_NEAREST_AFTER, ""},
+		{"2017", pb.DateMatch_DATE_MATCH_NEAREST, "2019"},
+		{"2016", pb.DateMatch_DATE_MATCH_NEAREST, "2015"},
+		{"2020-05", pb.DateMatch_DATE_MATCH_NEAREST, "2020-07"},
+		{"2021-06", pb.DateMatch_DATE_MATCH_NEAREST, "2022-01"},
+		{"2023", pb.DateMatch_DATE_MATCH_NEAREST, "2022-01"},
+	} {
+		if got := MatchDate(val, c.date, c.match); got != c.want {
+			t.Errorf("MatchDate(%s, %s) = %s, want %s", c.date, c.match, got, c.want)
+		}
+	}
+}
+
+func TestMatchDateAcrossSeries(t *testing.T) {
+	vals := []map[string]float64{
+		{"2010": 1, "2018": 2},
+		{"2020": 3},
+		{"2016": 4, "2019-06": 5},
+	}
+	for _, c := range []struct {
+		date      string
+		match     pb.DateMatch
+		wantIndex int
+		wantDate  string
+	}{
+		{"2020", pb.DateMatch_DATE_MATCH_NEAREST, 1, "2020"},
+		{"2020", pb.DateMatch_DATE_MATCH_EXACT, 1, "2020"},
+		{"2019", pb.DateMatch_DATE_MATCH_NEAREST_BEFORE, 2, "2019-06"},
+		{"2017", pb.DateMatch_DATE_MATCH_NEAREST_BEFORE, 2, "2016"},
+		{"2011", pb.DateMatch_DATE_MATCH_NEAREST_AFTER, 2, "2016"},
+		{"2009", pb.DateMatch_DATE_MATCH_NEAREST_BEFORE, -1, ""},
+		{"2021", pb.DateMatch_DATE_MATCH_EXACT, -1, ""},
+	} {
+		gotIndex, gotDate := MatchDateAcrossSeries(vals, c.date, c.match)
+		if gotIndex != c.wantIndex || gotDate != c.wantDate {
+			t.Errorf("MatchDateAcrossSeries(%s, %s) = %d, %s, want %d, %s",
+				c.date, c.match, gotIndex, gotDate, c.wantIndex, c.wantDate)
+		}
+	}
+}
@@ -29,6 +29,8 @@ import (
 	"cloud.google.com/go/bigquery"
 	pb "github.com/datacommonsorg/mixer/internal/proto"
 	"github.com/datacommonsorg/mixer/internal/server"
+	"github.com/datacommonsorg/mixer/internal/server/ranking"
+	"github.com/datacommonsorg/mixer/internal/server/recon"
 	"github.com/datacommonsorg/mixer/internal/server/resource"
 	"github.com/datacommonsorg/mixer/internal/store"
 	"github.com/datacommonsorg/mixer/internal/store/bigtable"
@@ -145,6 +147,7 @@ func setupInternal(
 		if err != nil {
 			return nil, nil, err
 		}
+		ranking.SetParentSvg(cache.ParentSvg)
 	} else {
 		cache = &resource.Cache{}
 	}
@@ -184,8 +187,8 @@ func newClient(
 ) (pb.MixerClient, pb.ReconClient, error) {
 	reconStore := store.NewStore(nil, nil, tables, "")
 	mixerServer := server.NewMixerServer(mixerStore, metadata, cache)
-	reconServer := server.NewReconServer(reconStore)
-	srv := grpc.NewServer()
+	reconServer := server.NewReconServer(reconStore, nil, recon.NewPolygonCache(1000))
+	srv := grpc.NewServer(grpc.UnaryInterceptor(server.ImportGroupInterceptor))
 	pb.RegisterMixerServer(srv, mixerServer)
 	pb.RegisterReconServer(srv, reconServer)
 	reflection.Register(srv)